@@ -79,34 +79,64 @@ func (doc *T) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
-func (doc *T) Validate(ctx context.Context) error {
+// Validate checks the document with the options, see validate.Option.
+// Options may also be set on the context with validate.WithOptions.
+func (doc *T) Validate(ctx context.Context, opts ...validate.Option) error {
+	ctx = validate.WithOptions(ctx, opts...)
+
+	if err := validate.Extensions(ctx, doc.Extensions); err != nil {
+		return err
+	}
+
 	if doc.AsyncAPI != asyncAPIVersion {
-		return fmt.Errorf("field asyncapi is required and should be equal %q: %w", asyncAPIVersion, validate.ErrWrongField)
+		err := fmt.Errorf("field asyncapi is required and should be equal %q: %w", asyncAPIVersion, validate.ErrWrongField)
+		if err := validate.Report(validate.At(ctx, "asyncapi"), validate.RuleValue, err); err != nil {
+			return err
+		}
 	}
 
 	if v := doc.Info; v != nil {
-		if err := doc.Info.Validate(ctx); err != nil {
+		if err := validate.Report(validate.At(ctx, "info"), validate.RuleValue, v.Validate(ctx)); err != nil {
 			return err
 		}
 	} else {
-		return fmt.Errorf("field info is required: %w", validate.ErrWrongField)
+		err := fmt.Errorf("field info is required: %w", validate.ErrWrongField)
+		if err := validate.Report(validate.At(ctx, "info"), validate.RuleRequired, err); err != nil {
+			return err
+		}
 	}
 
 	if v := doc.Servers; len(v) != 0 {
-		if err := v.Validate(ctx); err != nil {
+		if err := v.Validate(validate.At(ctx, "servers")); err != nil {
 			return err
 		}
 	}
 
 	if v := doc.Channels; len(v) != 0 {
-		if err := v.Validate(ctx); err != nil {
+		if err := v.Validate(validate.At(ctx, "channels")); err != nil {
 			return err
 		}
 	}
 
-	if err := doc.Components.Validate(ctx); err != nil {
+	if v := doc.Components; v != nil {
+		if err := v.Validate(validate.At(ctx, "components")); err != nil {
+			return err
+		}
+	}
+
+	if err := doc.validateSecurityRequirements(ctx); err != nil {
 		return err
 	}
 
-	return nil
+	return doc.validateUniqueNames(ctx)
+}
+
+// ValidateAll validates the whole document and returns validate.Errors holding all found problems,
+// each with a JSON pointer to the invalid value.
+func (doc *T) ValidateAll(ctx context.Context, opts ...validate.Option) error {
+	ctx = validate.WithOptions(ctx, opts...)
+
+	return validate.Collect(ctx, func(ctx context.Context) error {
+		return doc.Validate(ctx)
+	})
 }
@@ -1,13 +1,17 @@
 package spec
 
 import (
+	"context"
+	"errors"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/ghodss/yaml"
 
 	"github.com/rdmrcv/go-asyncapi2/spec/bindings"
+	"github.com/rdmrcv/go-asyncapi2/spec/validate"
 )
 
 func TestT_MarshalJSON(t *testing.T) {
@@ -186,10 +190,10 @@ func TestT_MarshalJSON(t *testing.T) {
 			Schemas: map[string]*openapi3.SchemaRef{
 				"lightMeasuredPayload": {
 					Value: &openapi3.Schema{
-						Type: "object",
+						Type: &openapi3.Types{"object"},
 						Properties: openapi3.Schemas{
 							"command": &openapi3.SchemaRef{Value: &openapi3.Schema{
-								Type:        "integer",
+								Type:        &openapi3.Types{"integer"},
 								Min:         openapi3.Float64Ptr(0),
 								Description: "Light intensity measured in lumens.",
 							}},
@@ -199,10 +203,10 @@ func TestT_MarshalJSON(t *testing.T) {
 				},
 				"turnOnOffPayload": {
 					Value: &openapi3.Schema{
-						Type: "object",
+						Type: &openapi3.Types{"object"},
 						Properties: openapi3.Schemas{
 							"command": &openapi3.SchemaRef{Value: &openapi3.Schema{
-								Type:        "string",
+								Type:        &openapi3.Types{"string"},
 								Enum:        []interface{}{"on", "off"},
 								Description: "Whether to turn on or off the light.",
 							}},
@@ -212,10 +216,10 @@ func TestT_MarshalJSON(t *testing.T) {
 				},
 				"dimLightPayload": {
 					Value: &openapi3.Schema{
-						Type: "object",
+						Type: &openapi3.Types{"object"},
 						Properties: openapi3.Schemas{
 							"percentage": &openapi3.SchemaRef{Value: &openapi3.Schema{
-								Type:        "integer",
+								Type:        &openapi3.Types{"integer"},
 								Description: "Percentage to which the light should be dimmed to.",
 								Min:         openapi3.Float64Ptr(0),
 								Max:         openapi3.Float64Ptr(100),
@@ -226,7 +230,7 @@ func TestT_MarshalJSON(t *testing.T) {
 				},
 				"sentAt": {
 					Value: &openapi3.Schema{
-						Type:        "string",
+						Type:        &openapi3.Types{"string"},
 						Format:      "date-time",
 						Description: "Date and time when the message was sent.",
 					},
@@ -288,7 +292,7 @@ func TestT_MarshalJSON(t *testing.T) {
 				"streetlightId": {
 					Description: "The ID of the streetlight.",
 					Schema: &openapi3.Schema{
-						Type: "string",
+						Type: &openapi3.Types{"string"},
 					},
 				},
 			},
@@ -296,10 +300,10 @@ func TestT_MarshalJSON(t *testing.T) {
 			MessageTraits: map[string]*MessageTrait{
 				"commonHeaders": {
 					Headers: &openapi3.SchemaRef{Value: &openapi3.Schema{
-						Type: "object",
+						Type: &openapi3.Types{"object"},
 						Properties: map[string]*openapi3.SchemaRef{
 							"my-app-header": {Value: &openapi3.Schema{
-								Type: "integer",
+								Type: &openapi3.Types{"integer"},
 								Min:  openapi3.Float64Ptr(0),
 								Max:  openapi3.Float64Ptr(100),
 							}},
@@ -312,7 +316,7 @@ func TestT_MarshalJSON(t *testing.T) {
 				"kafka": {
 					Bindings: &OperationBindings{
 						Kafka: &bindings.KafkaOperation{
-							ClientID: &openapi3.Schema{Type: "string"},
+							ClientID: &openapi3.Schema{Type: &openapi3.Types{"string"}},
 						},
 					},
 				},
@@ -343,3 +347,140 @@ func TestT_MarshalJSON(t *testing.T) {
 		panic(err)
 	}
 }
+
+func TestT_ValidateAll(t *testing.T) {
+	doc, err := NewLoader().LoadFromData([]byte(`
+asyncapi: 2.0.0
+info:
+  title: Orders API
+  version: 1.0.0
+channels:
+  orders/created:
+    subscribe:
+      message:
+        $ref: '#/components/messages/orderCreated'
+  orders/cancelled:
+    subscribe:
+      message:
+        correlationId:
+          description: Missing location
+components:
+  messages:
+    orderCreated:
+      payload:
+        type: unknown
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := doc.Validate(context.Background()); err == nil {
+		t.Fatal("expected an error")
+	}
+
+	doc.Channels["orders/updated"] = &Channel{
+		Publish: &OperationRef{Ref: "#/components/operations/update"},
+	}
+
+	err = doc.ValidateAll(context.Background())
+
+	var errs validate.Errors
+	if !errors.As(err, &errs) {
+		t.Fatalf("expected validate.Errors, got %v", err)
+	}
+
+	want := []validate.Error{
+		{Pointer: "/channels/orders~1cancelled/subscribe/message/correlationId/location", Rule: validate.RuleRequired},
+		{Pointer: "/channels/orders~1created/subscribe/message/payload", Rule: validate.RuleSchema},
+		{Pointer: "/channels/orders~1updated/publish", Rule: validate.RuleRef},
+	}
+
+	if len(errs) != len(want) {
+		t.Fatalf("expected %d errors, got %d:\n%v", len(want), len(errs), err)
+	}
+
+	for i, v := range want {
+		if errs[i].Pointer != v.Pointer || errs[i].Rule != v.Rule {
+			t.Errorf("expected %s error at %s, got %s error at %s", v.Rule, v.Pointer, errs[i].Rule, errs[i].Pointer)
+		}
+	}
+
+	if !errors.Is(err, validate.ErrWrongField) || !errors.Is(err, ErrUnresolvedRef) {
+		t.Fatalf("errors are not wrapped: %v", err)
+	}
+}
+
+func TestT_Validate_Options(t *testing.T) {
+	doc, err := NewLoader().LoadFromData([]byte(`
+asyncapi: 2.0.0
+info:
+  title: Orders API
+  version: 1.0.0
+channels:
+  orders:
+    descripton: Orders
+components:
+  schemas:
+    order id:
+      type: string
+      pattern: '(?<=a)b'
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	identifiers := validate.WithIdentifierRegExp(regexp.MustCompile(`^[a-z ]+$`))
+
+	tests := []struct {
+		name  string
+		opts  []validate.Option
+		rules []string
+	}{
+		{
+			name:  "default",
+			rules: []string{validate.RuleIdentifier, validate.RuleSchema},
+		},
+		{
+			name:  "identifier pattern",
+			opts:  []validate.Option{identifiers},
+			rules: []string{validate.RuleSchema},
+		},
+		{
+			name: "lenient",
+			opts: []validate.Option{identifiers, validate.Lenient()},
+		},
+		{
+			name:  "strict",
+			opts:  []validate.Option{identifiers, validate.Strict()},
+			rules: []string{validate.RuleExtension, validate.RuleSchema},
+		},
+		{
+			name:  "strict without extensions",
+			opts:  []validate.Option{identifiers, validate.Strict(), validate.DisableExtensionsValidation()},
+			rules: []string{validate.RuleSchema},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var rules []string
+
+			var errs validate.Errors
+			if err := doc.ValidateAll(context.Background(), tt.opts...); errors.As(err, &errs) {
+				for _, v := range errs {
+					rules = append(rules, v.Rule)
+				}
+			}
+
+			if len(rules) != len(tt.rules) {
+				t.Fatalf("expected %v errors, got %v", tt.rules, rules)
+			}
+
+			for i := range rules {
+				if rules[i] != tt.rules[i] {
+					t.Fatalf("expected %v errors, got %v", tt.rules, rules)
+				}
+			}
+		})
+	}
+}
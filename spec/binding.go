@@ -3,8 +3,10 @@ package spec
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 
 	"github.com/rdmrcv/go-asyncapi2/spec/bindings"
+	"github.com/rdmrcv/go-asyncapi2/spec/validate"
 )
 
 type ServersBindings map[string]*ServerBindings
@@ -12,28 +14,35 @@ type ServersBindings map[string]*ServerBindings
 // ServerBindings is defined in AsyncAPI spec: https://github.com/asyncapi/spec/blob/2.0.0/versions/2.0.0/asyncapi.md#server-bindings-object
 type ServerBindings struct {
 	Extensions map[string]interface{} `json:"-" yaml:"-"`
-
-	Http  *bindings.HttpServer  `json:"http,omitempty" yaml:"http,omitempty"`
-	Ws    *bindings.WsServer    `json:"ws,omitempty" yaml:"ws,omitempty"`
-	Kafka *bindings.KafkaServer `json:"kafka,omitempty" yaml:"kafka,omitempty"`
-
-	Amqp  interface{} `json:"amqp,omitempty" yaml:"amqp,omitempty"`
-	Amqp1 interface{} `json:"amqp1,omitempty" yaml:"amqp1,omitempty"`
-	Mqtt  interface{} `json:"mqtt,omitempty" yaml:"mqtt,omitempty"`
-	Mqtt5 interface{} `json:"mqtt5,omitempty" yaml:"mqtt5,omitempty"`
-	Nats  interface{} `json:"nats,omitempty" yaml:"nats,omitempty"`
-	Jms   interface{} `json:"jms,omitempty" yaml:"jms,omitempty"`
-	Sns   interface{} `json:"sns,omitempty" yaml:"sns,omitempty"`
-	Sqs   interface{} `json:"sqs,omitempty" yaml:"sqs,omitempty"`
-	Stomp interface{} `json:"stomp,omitempty" yaml:"stomp,omitempty"`
-	Redis interface{} `json:"redis,omitempty" yaml:"redis,omitempty"`
+	// Custom holds bindings of protocols registered with bindings.RegisterProtocol.
+	Custom map[string]bindings.Binding `json:"-" yaml:"-"`
+
+	Http   *bindings.HttpServer   `json:"http,omitempty" yaml:"http,omitempty"`
+	Ws     *bindings.WsServer     `json:"ws,omitempty" yaml:"ws,omitempty"`
+	Kafka  *bindings.KafkaServer  `json:"kafka,omitempty" yaml:"kafka,omitempty"`
+	Amqp   *bindings.AmqpServer   `json:"amqp,omitempty" yaml:"amqp,omitempty"`
+	Mqtt   *bindings.MqttServer   `json:"mqtt,omitempty" yaml:"mqtt,omitempty"`
+	Mqtt5  *bindings.Mqtt5Server  `json:"mqtt5,omitempty" yaml:"mqtt5,omitempty"`
+	Jms    *bindings.JmsServer    `json:"jms,omitempty" yaml:"jms,omitempty"`
+	Stomp  *bindings.StompServer  `json:"stomp,omitempty" yaml:"stomp,omitempty"`
+	Redis  *bindings.RedisServer  `json:"redis,omitempty" yaml:"redis,omitempty"`
+	Nats   *bindings.NatsServer   `json:"nats,omitempty" yaml:"nats,omitempty"`
+	Sns    *bindings.SnsServer    `json:"sns,omitempty" yaml:"sns,omitempty"`
+	Sqs    *bindings.SqsServer    `json:"sqs,omitempty" yaml:"sqs,omitempty"`
+	Amqp1  *bindings.Amqp1Server  `json:"amqp1,omitempty" yaml:"amqp1,omitempty"`
+	IbmMq  *bindings.IbmMqServer  `json:"ibmmq,omitempty" yaml:"ibmmq,omitempty"`
+	Solace *bindings.SolaceServer `json:"solace,omitempty" yaml:"solace,omitempty"`
+	Pulsar *bindings.PulsarServer `json:"pulsar,omitempty" yaml:"pulsar,omitempty"`
 }
 
 func (value *ServerBindings) MarshalJSON() ([]byte, error) {
-	m := make(map[string]interface{}, 13+len(value.Extensions))
+	m := make(map[string]interface{}, 16+len(value.Extensions)+len(value.Custom))
 	for k, v := range value.Extensions {
 		m[k] = v
 	}
+	for k, v := range value.Custom {
+		m[k] = v
+	}
 
 	if value.Http != nil {
 		m["http"] = value.Http
@@ -74,6 +83,15 @@ func (value *ServerBindings) MarshalJSON() ([]byte, error) {
 	if value.Redis != nil {
 		m["redis"] = value.Redis
 	}
+	if value.IbmMq != nil {
+		m["ibmmq"] = value.IbmMq
+	}
+	if value.Solace != nil {
+		m["solace"] = value.Solace
+	}
+	if value.Pulsar != nil {
+		m["pulsar"] = value.Pulsar
+	}
 
 	return json.Marshal(m)
 }
@@ -99,6 +117,17 @@ func (value *ServerBindings) UnmarshalJSON(data []byte) error {
 	delete(x.Extensions, "sqs")
 	delete(x.Extensions, "stomp")
 	delete(x.Extensions, "redis")
+	delete(x.Extensions, "ibmmq")
+	delete(x.Extensions, "solace")
+	delete(x.Extensions, "pulsar")
+
+	custom, err := decodeCustomBindings(x.Extensions, func(protocol bindings.Protocol) func() bindings.Binding {
+		return protocol.Server
+	})
+	if err != nil {
+		return err
+	}
+	x.Custom = custom
 
 	*value = ServerBindings(x)
 
@@ -106,20 +135,108 @@ func (value *ServerBindings) UnmarshalJSON(data []byte) error {
 }
 
 func (value *ServerBindings) Validate(ctx context.Context) error {
+	if err := validate.Extensions(ctx, value.Extensions); err != nil {
+		return err
+	}
+
 	if v := value.Http; v != nil {
-		if err := v.Validate(ctx); err != nil {
+		if err := v.Validate(validate.At(ctx, "http")); err != nil {
 			return err
 		}
 	}
 
 	if v := value.Ws; v != nil {
-		if err := v.Validate(ctx); err != nil {
+		if err := v.Validate(validate.At(ctx, "ws")); err != nil {
 			return err
 		}
 	}
 
 	if v := value.Kafka; v != nil {
-		if err := v.Validate(ctx); err != nil {
+		if err := v.Validate(validate.At(ctx, "kafka")); err != nil {
+			return err
+		}
+	}
+
+	if v := value.Amqp; v != nil {
+		if err := v.Validate(validate.At(ctx, "amqp")); err != nil {
+			return err
+		}
+	}
+
+	if v := value.Mqtt; v != nil {
+		if err := v.Validate(validate.At(ctx, "mqtt")); err != nil {
+			return err
+		}
+	}
+
+	if v := value.Mqtt5; v != nil {
+		if err := v.Validate(validate.At(ctx, "mqtt5")); err != nil {
+			return err
+		}
+	}
+
+	if v := value.Jms; v != nil {
+		if err := v.Validate(validate.At(ctx, "jms")); err != nil {
+			return err
+		}
+	}
+
+	if v := value.Stomp; v != nil {
+		if err := v.Validate(validate.At(ctx, "stomp")); err != nil {
+			return err
+		}
+	}
+
+	if v := value.Redis; v != nil {
+		if err := v.Validate(validate.At(ctx, "redis")); err != nil {
+			return err
+		}
+	}
+
+	if v := value.Nats; v != nil {
+		if err := v.Validate(validate.At(ctx, "nats")); err != nil {
+			return err
+		}
+	}
+
+	if v := value.Sns; v != nil {
+		if err := v.Validate(validate.At(ctx, "sns")); err != nil {
+			return err
+		}
+	}
+
+	if v := value.Sqs; v != nil {
+		if err := v.Validate(validate.At(ctx, "sqs")); err != nil {
+			return err
+		}
+	}
+
+	if v := value.Amqp1; v != nil {
+		if err := v.Validate(validate.At(ctx, "amqp1")); err != nil {
+			return err
+		}
+	}
+
+	if v := value.IbmMq; v != nil {
+		if err := v.Validate(validate.At(ctx, "ibmmq")); err != nil {
+			return err
+		}
+	}
+
+	if v := value.Solace; v != nil {
+		if err := v.Validate(validate.At(ctx, "solace")); err != nil {
+			return err
+		}
+	}
+
+	if v := value.Pulsar; v != nil {
+		if err := v.Validate(validate.At(ctx, "pulsar")); err != nil {
+			return err
+		}
+	}
+
+	for _, k := range sortedKeys(value.Custom) {
+		if err := value.Custom[k].Validate(validate.At(ctx, k)); err != nil {
 			return err
 		}
 	}
@@ -127,32 +244,45 @@ func (value *ServerBindings) Validate(ctx context.Context) error {
 	return nil
 }
 
+// Upgrade rewrites bindings into the shape of the latest versions of their protocols.
+func (value *ServerBindings) Upgrade() error {
+	return upgradeCustomBindings(value.Custom)
+}
+
 type ChannelsBindings map[string]*ChannelBindings
 
 // ChannelBindings is defined in AsyncAPI spec: https://github.com/asyncapi/spec/blob/2.0.0/versions/2.0.0/asyncapi.md#channel-bindings-object
 type ChannelBindings struct {
 	Extensions map[string]interface{} `json:"-" yaml:"-"`
-
-	Http  *bindings.HttpChannel  `json:"http,omitempty" yaml:"http,omitempty"`
-	Ws    *bindings.WsChannel    `json:"ws,omitempty" yaml:"ws,omitempty"`
-	Kafka *bindings.KafkaChannel `json:"kafka,omitempty" yaml:"kafka,omitempty"`
-	Amqp  interface{}            `json:"amqp,omitempty" yaml:"amqp,omitempty"`
-	Amqp1 interface{}            `json:"amqp1,omitempty" yaml:"amqp1,omitempty"`
-	Mqtt  interface{}            `json:"mqtt,omitempty" yaml:"mqtt,omitempty"`
-	Mqtt5 interface{}            `json:"mqtt5,omitempty" yaml:"mqtt5,omitempty"`
-	Nats  interface{}            `json:"nats,omitempty" yaml:"nats,omitempty"`
-	Jms   interface{}            `json:"jms,omitempty" yaml:"jms,omitempty"`
-	Sns   interface{}            `json:"sns,omitempty" yaml:"sns,omitempty"`
-	Sqs   interface{}            `json:"sqs,omitempty" yaml:"sqs,omitempty"`
-	Stomp interface{}            `json:"stomp,omitempty" yaml:"stomp,omitempty"`
-	Redis interface{}            `json:"redis,omitempty" yaml:"redis,omitempty"`
+	// Custom holds bindings of protocols registered with bindings.RegisterProtocol.
+	Custom map[string]bindings.Binding `json:"-" yaml:"-"`
+
+	Http         *bindings.HttpChannel         `json:"http,omitempty" yaml:"http,omitempty"`
+	Ws           *bindings.WsChannel           `json:"ws,omitempty" yaml:"ws,omitempty"`
+	Kafka        *bindings.KafkaChannel        `json:"kafka,omitempty" yaml:"kafka,omitempty"`
+	Amqp         *bindings.AmqpChannel         `json:"amqp,omitempty" yaml:"amqp,omitempty"`
+	Mqtt         *bindings.MqttChannel         `json:"mqtt,omitempty" yaml:"mqtt,omitempty"`
+	Mqtt5        *bindings.Mqtt5Channel        `json:"mqtt5,omitempty" yaml:"mqtt5,omitempty"`
+	Jms          *bindings.JmsChannel          `json:"jms,omitempty" yaml:"jms,omitempty"`
+	Stomp        *bindings.StompChannel        `json:"stomp,omitempty" yaml:"stomp,omitempty"`
+	Redis        *bindings.RedisChannel        `json:"redis,omitempty" yaml:"redis,omitempty"`
+	Nats         *bindings.NatsChannel         `json:"nats,omitempty" yaml:"nats,omitempty"`
+	Sns          *bindings.SnsChannel          `json:"sns,omitempty" yaml:"sns,omitempty"`
+	Sqs          *bindings.SqsChannel          `json:"sqs,omitempty" yaml:"sqs,omitempty"`
+	Amqp1        *bindings.Amqp1Channel        `json:"amqp1,omitempty" yaml:"amqp1,omitempty"`
+	IbmMq        *bindings.IbmMqChannel        `json:"ibmmq,omitempty" yaml:"ibmmq,omitempty"`
+	GooglePubSub *bindings.GooglePubSubChannel `json:"googlepubsub,omitempty" yaml:"googlepubsub,omitempty"`
+	Pulsar       *bindings.PulsarChannel       `json:"pulsar,omitempty" yaml:"pulsar,omitempty"`
 }
 
 func (value *ChannelBindings) MarshalJSON() ([]byte, error) {
-	m := make(map[string]interface{}, 13+len(value.Extensions))
+	m := make(map[string]interface{}, 16+len(value.Extensions)+len(value.Custom))
 	for k, v := range value.Extensions {
 		m[k] = v
 	}
+	for k, v := range value.Custom {
+		m[k] = v
+	}
 
 	if value.Http != nil {
 		m["http"] = value.Http
@@ -193,6 +323,15 @@ func (value *ChannelBindings) MarshalJSON() ([]byte, error) {
 	if value.Redis != nil {
 		m["redis"] = value.Redis
 	}
+	if value.IbmMq != nil {
+		m["ibmmq"] = value.IbmMq
+	}
+	if value.GooglePubSub != nil {
+		m["googlepubsub"] = value.GooglePubSub
+	}
+	if value.Pulsar != nil {
+		m["pulsar"] = value.Pulsar
+	}
 
 	return json.Marshal(m)
 }
@@ -218,6 +357,17 @@ func (value *ChannelBindings) UnmarshalJSON(data []byte) error {
 	delete(x.Extensions, "sqs")
 	delete(x.Extensions, "stomp")
 	delete(x.Extensions, "redis")
+	delete(x.Extensions, "ibmmq")
+	delete(x.Extensions, "googlepubsub")
+	delete(x.Extensions, "pulsar")
+
+	custom, err := decodeCustomBindings(x.Extensions, func(protocol bindings.Protocol) func() bindings.Binding {
+		return protocol.Channel
+	})
+	if err != nil {
+		return err
+	}
+	x.Custom = custom
 
 	*value = ChannelBindings(x)
 
@@ -225,14 +375,108 @@ func (value *ChannelBindings) UnmarshalJSON(data []byte) error {
 }
 
 func (value *ChannelBindings) Validate(ctx context.Context) error {
+	if err := validate.Extensions(ctx, value.Extensions); err != nil {
+		return err
+	}
+
 	if v := value.Http; v != nil {
-		if err := v.Validate(ctx); err != nil {
+		if err := v.Validate(validate.At(ctx, "http")); err != nil {
 			return err
 		}
 	}
 
 	if v := value.Ws; v != nil {
-		if err := v.Validate(ctx); err != nil {
+		if err := v.Validate(validate.At(ctx, "ws")); err != nil {
+			return err
+		}
+	}
+
+	if v := value.Kafka; v != nil {
+		if err := v.Validate(validate.At(ctx, "kafka")); err != nil {
+			return err
+		}
+	}
+
+	if v := value.Amqp; v != nil {
+		if err := v.Validate(validate.At(ctx, "amqp")); err != nil {
+			return err
+		}
+	}
+
+	if v := value.Mqtt; v != nil {
+		if err := v.Validate(validate.At(ctx, "mqtt")); err != nil {
+			return err
+		}
+	}
+
+	if v := value.Mqtt5; v != nil {
+		if err := v.Validate(validate.At(ctx, "mqtt5")); err != nil {
+			return err
+		}
+	}
+
+	if v := value.Jms; v != nil {
+		if err := v.Validate(validate.At(ctx, "jms")); err != nil {
+			return err
+		}
+	}
+
+	if v := value.Stomp; v != nil {
+		if err := v.Validate(validate.At(ctx, "stomp")); err != nil {
+			return err
+		}
+	}
+
+	if v := value.Redis; v != nil {
+		if err := v.Validate(validate.At(ctx, "redis")); err != nil {
+			return err
+		}
+	}
+
+	if v := value.Nats; v != nil {
+		if err := v.Validate(validate.At(ctx, "nats")); err != nil {
+			return err
+		}
+	}
+
+	if v := value.Sns; v != nil {
+		if err := v.Validate(validate.At(ctx, "sns")); err != nil {
+			return err
+		}
+	}
+
+	if v := value.Sqs; v != nil {
+		if err := v.Validate(validate.At(ctx, "sqs")); err != nil {
+			return err
+		}
+	}
+
+	if v := value.Amqp1; v != nil {
+		if err := v.Validate(validate.At(ctx, "amqp1")); err != nil {
+			return err
+		}
+	}
+
+	if v := value.IbmMq; v != nil {
+		if err := v.Validate(validate.At(ctx, "ibmmq")); err != nil {
+			return err
+		}
+	}
+
+	if v := value.GooglePubSub; v != nil {
+		if err := v.Validate(validate.At(ctx, "googlepubsub")); err != nil {
+			return err
+		}
+	}
+
+	if v := value.Pulsar; v != nil {
+		if err := v.Validate(validate.At(ctx, "pulsar")); err != nil {
+			return err
+		}
+	}
+
+	for _, k := range sortedKeys(value.Custom) {
+		if err := value.Custom[k].Validate(validate.At(ctx, k)); err != nil {
 			return err
 		}
 	}
@@ -240,33 +484,43 @@ func (value *ChannelBindings) Validate(ctx context.Context) error {
 	return nil
 }
 
+// Upgrade rewrites bindings into the shape of the latest versions of their protocols.
+func (value *ChannelBindings) Upgrade() error {
+	return upgradeCustomBindings(value.Custom)
+}
+
 type OperationsBindings map[string]*OperationBindings
 
 // OperationBindings is defined in AsyncAPI spec: https://github.com/asyncapi/spec/blob/2.0.0/versions/2.0.0/asyncapi.md#operation-bindings-object
 type OperationBindings struct {
 	Extensions map[string]interface{} `json:"-" yaml:"-"`
-
-	Http  *bindings.HttpOperation  `json:"http,omitempty" yaml:"http,omitempty"`
-	Ws    *bindings.WsOperation    `json:"ws,omitempty" yaml:"ws,omitempty"`
-	Kafka *bindings.KafkaOperation `json:"kafka,omitempty" yaml:"kafka,omitempty"`
-
-	Amqp  interface{} `json:"amqp,omitempty" yaml:"amqp,omitempty"`
-	Amqp1 interface{} `json:"amqp1,omitempty" yaml:"amqp1,omitempty"`
-	Mqtt  interface{} `json:"mqtt,omitempty" yaml:"mqtt,omitempty"`
-	Mqtt5 interface{} `json:"mqtt5,omitempty" yaml:"mqtt5,omitempty"`
-	Nats  interface{} `json:"nats,omitempty" yaml:"nats,omitempty"`
-	Jms   interface{} `json:"jms,omitempty" yaml:"jms,omitempty"`
-	Sns   interface{} `json:"sns,omitempty" yaml:"sns,omitempty"`
-	Sqs   interface{} `json:"sqs,omitempty" yaml:"sqs,omitempty"`
-	Stomp interface{} `json:"stomp,omitempty" yaml:"stomp,omitempty"`
-	Redis interface{} `json:"redis,omitempty" yaml:"redis,omitempty"`
+	// Custom holds bindings of protocols registered with bindings.RegisterProtocol.
+	Custom map[string]bindings.Binding `json:"-" yaml:"-"`
+
+	Http   *bindings.HttpOperation   `json:"http,omitempty" yaml:"http,omitempty"`
+	Ws     *bindings.WsOperation     `json:"ws,omitempty" yaml:"ws,omitempty"`
+	Kafka  *bindings.KafkaOperation  `json:"kafka,omitempty" yaml:"kafka,omitempty"`
+	Amqp   *bindings.AmqpOperation   `json:"amqp,omitempty" yaml:"amqp,omitempty"`
+	Mqtt   *bindings.MqttOperation   `json:"mqtt,omitempty" yaml:"mqtt,omitempty"`
+	Mqtt5  *bindings.Mqtt5Operation  `json:"mqtt5,omitempty" yaml:"mqtt5,omitempty"`
+	Nats   *bindings.NatsOperation   `json:"nats,omitempty" yaml:"nats,omitempty"`
+	Stomp  *bindings.StompOperation  `json:"stomp,omitempty" yaml:"stomp,omitempty"`
+	Redis  *bindings.RedisOperation  `json:"redis,omitempty" yaml:"redis,omitempty"`
+	Jms    *bindings.JmsOperation    `json:"jms,omitempty" yaml:"jms,omitempty"`
+	Sns    *bindings.SnsOperation    `json:"sns,omitempty" yaml:"sns,omitempty"`
+	Sqs    *bindings.SqsOperation    `json:"sqs,omitempty" yaml:"sqs,omitempty"`
+	Amqp1  *bindings.Amqp1Operation  `json:"amqp1,omitempty" yaml:"amqp1,omitempty"`
+	Solace *bindings.SolaceOperation `json:"solace,omitempty" yaml:"solace,omitempty"`
 }
 
 func (value *OperationBindings) MarshalJSON() ([]byte, error) {
-	m := make(map[string]interface{}, 13+len(value.Extensions))
+	m := make(map[string]interface{}, 14+len(value.Extensions)+len(value.Custom))
 	for k, v := range value.Extensions {
 		m[k] = v
 	}
+	for k, v := range value.Custom {
+		m[k] = v
+	}
 
 	if value.Http != nil {
 		m["http"] = value.Http
@@ -307,6 +561,9 @@ func (value *OperationBindings) MarshalJSON() ([]byte, error) {
 	if value.Redis != nil {
 		m["redis"] = value.Redis
 	}
+	if value.Solace != nil {
+		m["solace"] = value.Solace
+	}
 
 	return json.Marshal(m)
 }
@@ -332,6 +589,15 @@ func (value *OperationBindings) UnmarshalJSON(data []byte) error {
 	delete(x.Extensions, "sqs")
 	delete(x.Extensions, "stomp")
 	delete(x.Extensions, "redis")
+	delete(x.Extensions, "solace")
+
+	custom, err := decodeCustomBindings(x.Extensions, func(protocol bindings.Protocol) func() bindings.Binding {
+		return protocol.Operation
+	})
+	if err != nil {
+		return err
+	}
+	x.Custom = custom
 
 	*value = OperationBindings(x)
 
@@ -339,20 +605,96 @@ func (value *OperationBindings) UnmarshalJSON(data []byte) error {
 }
 
 func (value *OperationBindings) Validate(ctx context.Context) error {
+	if err := validate.Extensions(ctx, value.Extensions); err != nil {
+		return err
+	}
+
 	if v := value.Http; v != nil {
-		if err := v.Validate(ctx); err != nil {
+		if err := v.Validate(validate.At(ctx, "http")); err != nil {
 			return err
 		}
 	}
 
 	if v := value.Ws; v != nil {
-		if err := v.Validate(ctx); err != nil {
+		if err := v.Validate(validate.At(ctx, "ws")); err != nil {
 			return err
 		}
 	}
 
 	if v := value.Kafka; v != nil {
-		if err := v.Validate(ctx); err != nil {
+		if err := v.Validate(validate.At(ctx, "kafka")); err != nil {
+			return err
+		}
+	}
+
+	if v := value.Amqp; v != nil {
+		if err := v.Validate(validate.At(ctx, "amqp")); err != nil {
+			return err
+		}
+	}
+
+	if v := value.Mqtt; v != nil {
+		if err := v.Validate(validate.At(ctx, "mqtt")); err != nil {
+			return err
+		}
+	}
+
+	if v := value.Mqtt5; v != nil {
+		if err := v.Validate(validate.At(ctx, "mqtt5")); err != nil {
+			return err
+		}
+	}
+
+	if v := value.Nats; v != nil {
+		if err := v.Validate(validate.At(ctx, "nats")); err != nil {
+			return err
+		}
+	}
+
+	if v := value.Stomp; v != nil {
+		if err := v.Validate(validate.At(ctx, "stomp")); err != nil {
+			return err
+		}
+	}
+
+	if v := value.Redis; v != nil {
+		if err := v.Validate(validate.At(ctx, "redis")); err != nil {
+			return err
+		}
+	}
+
+	if v := value.Jms; v != nil {
+		if err := v.Validate(validate.At(ctx, "jms")); err != nil {
+			return err
+		}
+	}
+
+	if v := value.Sns; v != nil {
+		if err := v.Validate(validate.At(ctx, "sns")); err != nil {
+			return err
+		}
+	}
+
+	if v := value.Sqs; v != nil {
+		if err := v.Validate(validate.At(ctx, "sqs")); err != nil {
+			return err
+		}
+	}
+
+	if v := value.Amqp1; v != nil {
+		if err := v.Validate(validate.At(ctx, "amqp1")); err != nil {
+			return err
+		}
+	}
+
+	if v := value.Solace; v != nil {
+		if err := v.Validate(validate.At(ctx, "solace")); err != nil {
+			return err
+		}
+	}
+
+	for _, k := range sortedKeys(value.Custom) {
+		if err := value.Custom[k].Validate(validate.At(ctx, k)); err != nil {
 			return err
 		}
 	}
@@ -360,33 +702,50 @@ func (value *OperationBindings) Validate(ctx context.Context) error {
 	return nil
 }
 
+// Upgrade rewrites bindings into the shape of the latest versions of their protocols.
+func (value *OperationBindings) Upgrade() error {
+	if v := value.Http; v != nil {
+		if err := v.Upgrade(); err != nil {
+			return fmt.Errorf("http binding: %w", err)
+		}
+	}
+
+	return upgradeCustomBindings(value.Custom)
+}
+
 type MessagesBindings map[string]*MessageBindings
 
 // MessageBindings is defined in AsyncAPI spec: https://github.com/asyncapi/spec/blob/2.0.0/versions/2.0.0/asyncapi.md#message-bindings-object
 type MessageBindings struct {
 	Extensions map[string]interface{} `json:"-" yaml:"-"`
-
-	Http  *bindings.HttpMessage  `json:"http,omitempty" yaml:"http,omitempty"`
-	Ws    *bindings.WsMessage    `json:"ws,omitempty" yaml:"ws,omitempty"`
-	Kafka *bindings.KafkaMessage `json:"kafka,omitempty" yaml:"kafka,omitempty"`
-
-	Amqp  interface{} `json:"amqp,omitempty" yaml:"amqp,omitempty"`
-	Amqp1 interface{} `json:"amqp1,omitempty" yaml:"amqp1,omitempty"`
-	Mqtt  interface{} `json:"mqtt,omitempty" yaml:"mqtt,omitempty"`
-	Mqtt5 interface{} `json:"mqtt5,omitempty" yaml:"mqtt5,omitempty"`
-	Nats  interface{} `json:"nats,omitempty" yaml:"nats,omitempty"`
-	Jms   interface{} `json:"jms,omitempty" yaml:"jms,omitempty"`
-	Sns   interface{} `json:"sns,omitempty" yaml:"sns,omitempty"`
-	Sqs   interface{} `json:"sqs,omitempty" yaml:"sqs,omitempty"`
-	Stomp interface{} `json:"stomp,omitempty" yaml:"stomp,omitempty"`
-	Redis interface{} `json:"redis,omitempty" yaml:"redis,omitempty"`
+	// Custom holds bindings of protocols registered with bindings.RegisterProtocol.
+	Custom map[string]bindings.Binding `json:"-" yaml:"-"`
+
+	Http         *bindings.HttpMessage         `json:"http,omitempty" yaml:"http,omitempty"`
+	Ws           *bindings.WsMessage           `json:"ws,omitempty" yaml:"ws,omitempty"`
+	Kafka        *bindings.KafkaMessage        `json:"kafka,omitempty" yaml:"kafka,omitempty"`
+	Amqp         *bindings.AmqpMessage         `json:"amqp,omitempty" yaml:"amqp,omitempty"`
+	Mqtt         *bindings.MqttMessage         `json:"mqtt,omitempty" yaml:"mqtt,omitempty"`
+	Mqtt5        *bindings.Mqtt5Message        `json:"mqtt5,omitempty" yaml:"mqtt5,omitempty"`
+	Jms          *bindings.JmsMessage          `json:"jms,omitempty" yaml:"jms,omitempty"`
+	Stomp        *bindings.StompMessage        `json:"stomp,omitempty" yaml:"stomp,omitempty"`
+	Redis        *bindings.RedisMessage        `json:"redis,omitempty" yaml:"redis,omitempty"`
+	Nats         *bindings.NatsMessage         `json:"nats,omitempty" yaml:"nats,omitempty"`
+	Sns          *bindings.SnsMessage          `json:"sns,omitempty" yaml:"sns,omitempty"`
+	Sqs          *bindings.SqsMessage          `json:"sqs,omitempty" yaml:"sqs,omitempty"`
+	Amqp1        *bindings.Amqp1Message        `json:"amqp1,omitempty" yaml:"amqp1,omitempty"`
+	IbmMq        *bindings.IbmMqMessage        `json:"ibmmq,omitempty" yaml:"ibmmq,omitempty"`
+	GooglePubSub *bindings.GooglePubSubMessage `json:"googlepubsub,omitempty" yaml:"googlepubsub,omitempty"`
 }
 
 func (value *MessageBindings) MarshalJSON() ([]byte, error) {
-	m := make(map[string]interface{}, 13+len(value.Extensions))
+	m := make(map[string]interface{}, 15+len(value.Extensions)+len(value.Custom))
 	for k, v := range value.Extensions {
 		m[k] = v
 	}
+	for k, v := range value.Custom {
+		m[k] = v
+	}
 
 	if value.Http != nil {
 		m["http"] = value.Http
@@ -427,6 +786,12 @@ func (value *MessageBindings) MarshalJSON() ([]byte, error) {
 	if value.Redis != nil {
 		m["redis"] = value.Redis
 	}
+	if value.IbmMq != nil {
+		m["ibmmq"] = value.IbmMq
+	}
+	if value.GooglePubSub != nil {
+		m["googlepubsub"] = value.GooglePubSub
+	}
 
 	return json.Marshal(m)
 }
@@ -452,6 +817,16 @@ func (value *MessageBindings) UnmarshalJSON(data []byte) error {
 	delete(x.Extensions, "sqs")
 	delete(x.Extensions, "stomp")
 	delete(x.Extensions, "redis")
+	delete(x.Extensions, "ibmmq")
+	delete(x.Extensions, "googlepubsub")
+
+	custom, err := decodeCustomBindings(x.Extensions, func(protocol bindings.Protocol) func() bindings.Binding {
+		return protocol.Message
+	})
+	if err != nil {
+		return err
+	}
+	x.Custom = custom
 
 	*value = MessageBindings(x)
 
@@ -459,23 +834,163 @@ func (value *MessageBindings) UnmarshalJSON(data []byte) error {
 }
 
 func (value *MessageBindings) Validate(ctx context.Context) error {
+	if err := validate.Extensions(ctx, value.Extensions); err != nil {
+		return err
+	}
+
 	if v := value.Http; v != nil {
-		if err := v.Validate(ctx); err != nil {
+		if err := v.Validate(validate.At(ctx, "http")); err != nil {
 			return err
 		}
 	}
 
 	if v := value.Ws; v != nil {
-		if err := v.Validate(ctx); err != nil {
+		if err := v.Validate(validate.At(ctx, "ws")); err != nil {
 			return err
 		}
 	}
 
 	if v := value.Kafka; v != nil {
-		if err := v.Validate(ctx); err != nil {
+		if err := v.Validate(validate.At(ctx, "kafka")); err != nil {
 			return err
 		}
 	}
 
+	if v := value.Amqp; v != nil {
+		if err := v.Validate(validate.At(ctx, "amqp")); err != nil {
+			return err
+		}
+	}
+
+	if v := value.Mqtt; v != nil {
+		if err := v.Validate(validate.At(ctx, "mqtt")); err != nil {
+			return err
+		}
+	}
+
+	if v := value.Mqtt5; v != nil {
+		if err := v.Validate(validate.At(ctx, "mqtt5")); err != nil {
+			return err
+		}
+	}
+
+	if v := value.Jms; v != nil {
+		if err := v.Validate(validate.At(ctx, "jms")); err != nil {
+			return err
+		}
+	}
+
+	if v := value.Stomp; v != nil {
+		if err := v.Validate(validate.At(ctx, "stomp")); err != nil {
+			return err
+		}
+	}
+
+	if v := value.Redis; v != nil {
+		if err := v.Validate(validate.At(ctx, "redis")); err != nil {
+			return err
+		}
+	}
+
+	if v := value.Nats; v != nil {
+		if err := v.Validate(validate.At(ctx, "nats")); err != nil {
+			return err
+		}
+	}
+
+	if v := value.Sns; v != nil {
+		if err := v.Validate(validate.At(ctx, "sns")); err != nil {
+			return err
+		}
+	}
+
+	if v := value.Sqs; v != nil {
+		if err := v.Validate(validate.At(ctx, "sqs")); err != nil {
+			return err
+		}
+	}
+
+	if v := value.Amqp1; v != nil {
+		if err := v.Validate(validate.At(ctx, "amqp1")); err != nil {
+			return err
+		}
+	}
+
+	if v := value.IbmMq; v != nil {
+		if err := v.Validate(validate.At(ctx, "ibmmq")); err != nil {
+			return err
+		}
+	}
+
+	if v := value.GooglePubSub; v != nil {
+		if err := v.Validate(validate.At(ctx, "googlepubsub")); err != nil {
+			return err
+		}
+	}
+
+	for _, k := range sortedKeys(value.Custom) {
+		if err := value.Custom[k].Validate(validate.At(ctx, k)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Upgrade rewrites bindings into the shape of the latest versions of their protocols.
+func (value *MessageBindings) Upgrade() error {
+	if v := value.Http; v != nil {
+		if err := v.Upgrade(); err != nil {
+			return fmt.Errorf("http binding: %w", err)
+		}
+	}
+
+	return upgradeCustomBindings(value.Custom)
+}
+
+// upgradeCustomBindings upgrades bindings of registered protocols that implement bindings.Upgrader.
+func upgradeCustomBindings(custom map[string]bindings.Binding) error {
+	for _, k := range sortedKeys(custom) {
+		if v, ok := custom[k].(bindings.Upgrader); ok {
+			if err := v.Upgrade(); err != nil {
+				return fmt.Errorf("%s binding: %w", k, err)
+			}
+		}
+	}
+
 	return nil
 }
+
+// decodeCustomBindings moves bindings of registered protocols out of the extensions.
+func decodeCustomBindings(
+	extensions map[string]interface{},
+	constructor func(bindings.Protocol) func() bindings.Binding,
+) (map[string]bindings.Binding, error) {
+	var custom map[string]bindings.Binding
+
+	for _, k := range sortedKeys(extensions) {
+		protocol, ok := bindings.RegisteredProtocol(k)
+		if !ok || constructor(protocol) == nil {
+			continue
+		}
+
+		data, err := json.Marshal(extensions[k])
+		if err != nil {
+			return nil, err
+		}
+
+		binding := constructor(protocol)()
+		if err := json.Unmarshal(data, binding); err != nil {
+			return nil, fmt.Errorf("%s binding: %w", k, err)
+		}
+
+		if custom == nil {
+			custom = make(map[string]bindings.Binding)
+		}
+
+		custom[k] = binding
+		delete(extensions, k)
+	}
+
+	return custom, nil
+}
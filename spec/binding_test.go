@@ -0,0 +1,179 @@
+package spec
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/rdmrcv/go-asyncapi2/spec/bindings"
+	"github.com/rdmrcv/go-asyncapi2/spec/validate"
+)
+
+func TestChannelBindings_UnmarshalJSON(t *testing.T) {
+	data := []byte(`{
+		"ibmmq": {"destinationType": "queue", "queue": {"objectName": "DEV.QUEUE.1"}, "maxMsgLength": 1024},
+		"googlepubsub": {
+			"messageRetentionDuration": "86400s",
+			"messageStoragePolicy": {"allowedPersistenceRegions": ["us-central1"]},
+			"schemaSettings": {"encoding": "BINARY", "name": "projects/your-project/schemas/message-avro"}
+		},
+		"pulsar": {"namespace": "staging", "persistence": "persistent", "retention": {"time": 7, "size": 1000}},
+		"amqp1": {},
+		"x-custom": {"enabled": true}
+	}`)
+
+	var value ChannelBindings
+	if err := json.Unmarshal(data, &value); err != nil {
+		t.Fatal(err)
+	}
+
+	if value.IbmMq == nil || value.IbmMq.Queue.ObjectName != "DEV.QUEUE.1" {
+		t.Errorf("unexpected ibmmq binding %+v", value.IbmMq)
+	}
+
+	if value.GooglePubSub == nil || value.GooglePubSub.SchemaSettings.Name == "" {
+		t.Errorf("unexpected googlepubsub binding %+v", value.GooglePubSub)
+	}
+
+	if value.Pulsar == nil || *value.Pulsar.Retention.Time != 7 {
+		t.Errorf("unexpected pulsar binding %+v", value.Pulsar)
+	}
+
+	if value.Amqp1 == nil {
+		t.Error("expected amqp1 binding")
+	}
+
+	if len(value.Extensions) != 1 || value.Extensions["x-custom"] == nil {
+		t.Errorf("unexpected extensions %v", value.Extensions)
+	}
+
+	if err := validate.Collect(context.Background(), value.Validate); err != nil {
+		t.Error(err)
+	}
+
+	out, err := json.Marshal(&value)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var expected, actual interface{}
+	_ = json.Unmarshal(data, &expected)
+	_ = json.Unmarshal(out, &actual)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("expected %s, got %s", data, out)
+	}
+}
+
+func TestOperationBindings_Validate(t *testing.T) {
+	data := []byte(`{
+		"http": {"type": "request", "query": {"type": "object", "properties": {"id": {"type": "string"}}}},
+		"solace": {
+			"destinations": [
+				{"destinationType": "queue", "queue": {"name": "CreatedHREvents", "accessType": "shared"}},
+				{"destinationType": "topic", "deliveryMode": "persistent"}
+			],
+			"priority": 256
+		}
+	}`)
+
+	var value OperationBindings
+	if err := json.Unmarshal(data, &value); err != nil {
+		t.Fatal(err)
+	}
+
+	err := validate.Collect(context.Background(), value.Validate)
+
+	var errs validate.Errors
+	if !errors.As(err, &errs) {
+		t.Fatalf("expected validate.Errors, got %v", err)
+	}
+
+	if value.Http == nil || value.Http.Type != bindings.HttpOperationBindingRequest || value.Http.Extensions["type"] != nil {
+		t.Fatalf("unexpected http binding %+v", value.Http)
+	}
+
+	pointers := []string{"/http/method", "/solace/destinations/0/queue/accessType", "/solace/priority"}
+	if len(errs) != len(pointers) {
+		t.Fatalf("expected errors at %v, got %v", pointers, err)
+	}
+
+	for i, v := range pointers {
+		if errs[i].Pointer != v {
+			t.Errorf("expected error at %s, got %s", v, errs[i].Pointer)
+		}
+	}
+}
+
+type inhouseChannel struct {
+	Partitions int `json:"partitions"`
+}
+
+func (binding *inhouseChannel) Validate(ctx context.Context) error {
+	if binding.Partitions <= 0 {
+		err := fmt.Errorf("partitions must be greater than 0: %w", validate.ErrWrongField)
+
+		return validate.Report(validate.At(ctx, "partitions"), validate.RuleValue, err)
+	}
+
+	return nil
+}
+
+func TestChannelBindings_RegisteredProtocol(t *testing.T) {
+	data := []byte(`{"inhouse": {"partitions": 0}, "kafka": {"topic": "lights"}}`)
+
+	var unregistered ChannelBindings
+	if err := json.Unmarshal(data, &unregistered); err != nil {
+		t.Fatal(err)
+	}
+
+	if unregistered.Custom != nil || unregistered.Extensions["inhouse"] == nil {
+		t.Errorf("expected the binding in extensions, got %v", unregistered.Custom)
+	}
+
+	bindings.RegisterProtocol("inhouse", bindings.Protocol{
+		Channel: func() bindings.Binding { return &inhouseChannel{} },
+	})
+	defer bindings.UnregisterProtocol("inhouse")
+
+	var registered ChannelBindings
+	if err := json.Unmarshal(data, &registered); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := registered.Custom["inhouse"].(*inhouseChannel); !ok || len(registered.Extensions) != 0 {
+		t.Fatalf("expected the typed binding, got %v and extensions %v", registered.Custom, registered.Extensions)
+	}
+
+	err := validate.Collect(context.Background(), registered.Validate)
+
+	var errs validate.Errors
+	if !errors.As(err, &errs) || len(errs) != 1 || errs[0].Pointer != "/inhouse/partitions" {
+		t.Errorf("expected error at /inhouse/partitions, got %v", err)
+	}
+
+	out, err := json.Marshal(&registered)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var expected, actual interface{}
+	_ = json.Unmarshal(data, &expected)
+	_ = json.Unmarshal(out, &actual)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("expected %s, got %s", data, out)
+	}
+
+	var operation OperationBindings
+	if err := json.Unmarshal(data, &operation); err != nil {
+		t.Fatal(err)
+	}
+
+	if operation.Custom != nil || operation.Extensions["inhouse"] == nil {
+		t.Error("expected the binding without operation constructor in extensions")
+	}
+}
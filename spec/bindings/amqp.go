@@ -0,0 +1,499 @@
+package bindings
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/rdmrcv/go-asyncapi2/spec/validate"
+)
+
+const amqpNameMaxLength = 255
+
+type AmqpChannelIs string
+
+const (
+	AmqpChannelIsRoutingKey AmqpChannelIs = "routingKey"
+	AmqpChannelIsQueue      AmqpChannelIs = "queue"
+)
+
+type AmqpExchangeType string
+
+const (
+	AmqpExchangeTopic   AmqpExchangeType = "topic"
+	AmqpExchangeDirect  AmqpExchangeType = "direct"
+	AmqpExchangeFanout  AmqpExchangeType = "fanout"
+	AmqpExchangeDefault AmqpExchangeType = "default"
+	AmqpExchangeHeaders AmqpExchangeType = "headers"
+)
+
+const (
+	AmqpDeliveryModeTransient  = 1
+	AmqpDeliveryModePersistent = 2
+)
+
+// AmqpServer is defined in AsyncAPI spec: https://github.com/asyncapi/bindings/tree/master/amqp#server-binding-object
+type AmqpServer struct {
+	Extensions map[string]interface{} `json:"-" yaml:"-"`
+
+	BindingVersion string `json:"bindingVersion,omitempty" yaml:"bindingVersion,omitempty"`
+}
+
+func (binding *AmqpServer) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{}, 1+len(binding.Extensions))
+	for k, v := range binding.Extensions {
+		m[k] = v
+	}
+
+	if len(binding.BindingVersion) != 0 {
+		m["bindingVersion"] = binding.BindingVersion
+	}
+
+	return json.Marshal(m)
+}
+
+func (binding *AmqpServer) UnmarshalJSON(data []byte) error {
+	type AmqpServerBis AmqpServer
+	var x AmqpServerBis
+	if err := json.Unmarshal(data, &x); err != nil {
+		return err
+	}
+	_ = json.Unmarshal(data, &x.Extensions)
+
+	delete(x.Extensions, "bindingVersion")
+
+	*binding = AmqpServer(x)
+
+	return nil
+}
+
+func (binding *AmqpServer) Validate(ctx context.Context) error {
+	if err := validate.Extensions(ctx, binding.Extensions); err != nil {
+		return err
+	}
+
+	if err := validateBindingVersion(ctx, "amqp", binding.BindingVersion); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// AmqpChannel is defined in AsyncAPI spec: https://github.com/asyncapi/bindings/tree/master/amqp#channel-binding-object
+type AmqpChannel struct {
+	Extensions map[string]interface{} `json:"-" yaml:"-"`
+
+	Is             AmqpChannelIs `json:"is,omitempty" yaml:"is,omitempty"`
+	Exchange       *AmqpExchange `json:"exchange,omitempty" yaml:"exchange,omitempty"`
+	Queue          *AmqpQueue    `json:"queue,omitempty" yaml:"queue,omitempty"`
+	BindingVersion string        `json:"bindingVersion,omitempty" yaml:"bindingVersion,omitempty"`
+}
+
+func (binding *AmqpChannel) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{}, 4+len(binding.Extensions))
+	for k, v := range binding.Extensions {
+		m[k] = v
+	}
+
+	if len(binding.Is) != 0 {
+		m["is"] = binding.Is
+	}
+	if binding.Exchange != nil {
+		m["exchange"] = binding.Exchange
+	}
+	if binding.Queue != nil {
+		m["queue"] = binding.Queue
+	}
+	if len(binding.BindingVersion) != 0 {
+		m["bindingVersion"] = binding.BindingVersion
+	}
+
+	return json.Marshal(m)
+}
+
+func (binding *AmqpChannel) UnmarshalJSON(data []byte) error {
+	type AmqpChannelBis AmqpChannel
+	var x AmqpChannelBis
+	if err := json.Unmarshal(data, &x); err != nil {
+		return err
+	}
+	_ = json.Unmarshal(data, &x.Extensions)
+
+	delete(x.Extensions, "is")
+	delete(x.Extensions, "exchange")
+	delete(x.Extensions, "queue")
+	delete(x.Extensions, "bindingVersion")
+
+	*binding = AmqpChannel(x)
+
+	return nil
+}
+
+func (binding *AmqpChannel) Validate(ctx context.Context) error {
+	if err := validate.Extensions(ctx, binding.Extensions); err != nil {
+		return err
+	}
+
+	err := validateVersionedFields(ctx, "amqp", binding.BindingVersion, []versionedField{
+		{name: "exchange/vhost", since: "0.2.0", set: binding.Exchange != nil && len(binding.Exchange.Vhost) != 0},
+		{name: "queue/vhost", since: "0.2.0", set: binding.Queue != nil && len(binding.Queue.Vhost) != 0},
+	})
+	if err != nil {
+		return err
+	}
+
+	switch binding.Is {
+	case "", AmqpChannelIsRoutingKey, AmqpChannelIsQueue:
+	default:
+		err := fmt.Errorf("is must be either routingKey or queue: %w", validate.ErrWrongField)
+		if err := validate.Report(validate.At(ctx, "is"), validate.RuleValue, err); err != nil {
+			return err
+		}
+	}
+
+	if v := binding.Exchange; v != nil {
+		if err := v.Validate(validate.At(ctx, "exchange")); err != nil {
+			return err
+		}
+	}
+
+	if v := binding.Queue; v != nil {
+		if err := v.Validate(validate.At(ctx, "queue")); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AmqpExchange describes the exchange of the channel when it is a routing key.
+type AmqpExchange struct {
+	Extensions map[string]interface{} `json:"-" yaml:"-"`
+
+	Name       string           `json:"name,omitempty" yaml:"name,omitempty"`
+	Type       AmqpExchangeType `json:"type,omitempty" yaml:"type,omitempty"`
+	Durable    *bool            `json:"durable,omitempty" yaml:"durable,omitempty"`
+	AutoDelete *bool            `json:"autoDelete,omitempty" yaml:"autoDelete,omitempty"`
+	Vhost      string           `json:"vhost,omitempty" yaml:"vhost,omitempty"`
+}
+
+func (value *AmqpExchange) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{}, 5+len(value.Extensions))
+	for k, v := range value.Extensions {
+		m[k] = v
+	}
+
+	if len(value.Name) != 0 {
+		m["name"] = value.Name
+	}
+	if len(value.Type) != 0 {
+		m["type"] = value.Type
+	}
+	if value.Durable != nil {
+		m["durable"] = value.Durable
+	}
+	if value.AutoDelete != nil {
+		m["autoDelete"] = value.AutoDelete
+	}
+	if len(value.Vhost) != 0 {
+		m["vhost"] = value.Vhost
+	}
+
+	return json.Marshal(m)
+}
+
+func (value *AmqpExchange) UnmarshalJSON(data []byte) error {
+	type AmqpExchangeBis AmqpExchange
+	var x AmqpExchangeBis
+	if err := json.Unmarshal(data, &x); err != nil {
+		return err
+	}
+	_ = json.Unmarshal(data, &x.Extensions)
+
+	delete(x.Extensions, "name")
+	delete(x.Extensions, "type")
+	delete(x.Extensions, "durable")
+	delete(x.Extensions, "autoDelete")
+	delete(x.Extensions, "vhost")
+
+	*value = AmqpExchange(x)
+
+	return nil
+}
+
+func (value *AmqpExchange) Validate(ctx context.Context) error {
+	if err := validate.Extensions(ctx, value.Extensions); err != nil {
+		return err
+	}
+
+	if len(value.Name) > amqpNameMaxLength {
+		err := fmt.Errorf("name must be at most %d characters: %w", amqpNameMaxLength, validate.ErrWrongField)
+		if err := validate.Report(validate.At(ctx, "name"), validate.RuleValue, err); err != nil {
+			return err
+		}
+	}
+
+	switch value.Type {
+	case "", AmqpExchangeTopic, AmqpExchangeDirect, AmqpExchangeFanout, AmqpExchangeDefault, AmqpExchangeHeaders:
+	default:
+		err := fmt.Errorf(
+			"type must be one of topic, direct, fanout, default or headers: %w",
+			validate.ErrWrongField,
+		)
+		if err := validate.Report(validate.At(ctx, "type"), validate.RuleValue, err); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AmqpQueue describes the queue of the channel when it is a queue.
+type AmqpQueue struct {
+	Extensions map[string]interface{} `json:"-" yaml:"-"`
+
+	Name       string `json:"name,omitempty" yaml:"name,omitempty"`
+	Durable    *bool  `json:"durable,omitempty" yaml:"durable,omitempty"`
+	Exclusive  *bool  `json:"exclusive,omitempty" yaml:"exclusive,omitempty"`
+	AutoDelete *bool  `json:"autoDelete,omitempty" yaml:"autoDelete,omitempty"`
+	Vhost      string `json:"vhost,omitempty" yaml:"vhost,omitempty"`
+}
+
+func (value *AmqpQueue) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{}, 5+len(value.Extensions))
+	for k, v := range value.Extensions {
+		m[k] = v
+	}
+
+	if len(value.Name) != 0 {
+		m["name"] = value.Name
+	}
+	if value.Durable != nil {
+		m["durable"] = value.Durable
+	}
+	if value.Exclusive != nil {
+		m["exclusive"] = value.Exclusive
+	}
+	if value.AutoDelete != nil {
+		m["autoDelete"] = value.AutoDelete
+	}
+	if len(value.Vhost) != 0 {
+		m["vhost"] = value.Vhost
+	}
+
+	return json.Marshal(m)
+}
+
+func (value *AmqpQueue) UnmarshalJSON(data []byte) error {
+	type AmqpQueueBis AmqpQueue
+	var x AmqpQueueBis
+	if err := json.Unmarshal(data, &x); err != nil {
+		return err
+	}
+	_ = json.Unmarshal(data, &x.Extensions)
+
+	delete(x.Extensions, "name")
+	delete(x.Extensions, "durable")
+	delete(x.Extensions, "exclusive")
+	delete(x.Extensions, "autoDelete")
+	delete(x.Extensions, "vhost")
+
+	*value = AmqpQueue(x)
+
+	return nil
+}
+
+func (value *AmqpQueue) Validate(ctx context.Context) error {
+	if err := validate.Extensions(ctx, value.Extensions); err != nil {
+		return err
+	}
+
+	if len(value.Name) > amqpNameMaxLength {
+		err := fmt.Errorf("name must be at most %d characters: %w", amqpNameMaxLength, validate.ErrWrongField)
+		if err := validate.Report(validate.At(ctx, "name"), validate.RuleValue, err); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AmqpOperation is defined in AsyncAPI spec: https://github.com/asyncapi/bindings/tree/master/amqp#operation-binding-object
+type AmqpOperation struct {
+	Extensions map[string]interface{} `json:"-" yaml:"-"`
+
+	Expiration     *int     `json:"expiration,omitempty" yaml:"expiration,omitempty"`
+	UserID         string   `json:"userId,omitempty" yaml:"userId,omitempty"`
+	CC             []string `json:"cc,omitempty" yaml:"cc,omitempty"`
+	Priority       *int     `json:"priority,omitempty" yaml:"priority,omitempty"`
+	DeliveryMode   int      `json:"deliveryMode,omitempty" yaml:"deliveryMode,omitempty"`
+	Mandatory      *bool    `json:"mandatory,omitempty" yaml:"mandatory,omitempty"`
+	BCC            []string `json:"bcc,omitempty" yaml:"bcc,omitempty"`
+	ReplyTo        string   `json:"replyTo,omitempty" yaml:"replyTo,omitempty"`
+	Timestamp      *bool    `json:"timestamp,omitempty" yaml:"timestamp,omitempty"`
+	Ack            *bool    `json:"ack,omitempty" yaml:"ack,omitempty"`
+	BindingVersion string   `json:"bindingVersion,omitempty" yaml:"bindingVersion,omitempty"`
+}
+
+func (binding *AmqpOperation) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{}, 11+len(binding.Extensions))
+	for k, v := range binding.Extensions {
+		m[k] = v
+	}
+
+	if binding.Expiration != nil {
+		m["expiration"] = binding.Expiration
+	}
+	if len(binding.UserID) != 0 {
+		m["userId"] = binding.UserID
+	}
+	if len(binding.CC) != 0 {
+		m["cc"] = binding.CC
+	}
+	if binding.Priority != nil {
+		m["priority"] = binding.Priority
+	}
+	if binding.DeliveryMode != 0 {
+		m["deliveryMode"] = binding.DeliveryMode
+	}
+	if binding.Mandatory != nil {
+		m["mandatory"] = binding.Mandatory
+	}
+	if len(binding.BCC) != 0 {
+		m["bcc"] = binding.BCC
+	}
+	if len(binding.ReplyTo) != 0 {
+		m["replyTo"] = binding.ReplyTo
+	}
+	if binding.Timestamp != nil {
+		m["timestamp"] = binding.Timestamp
+	}
+	if binding.Ack != nil {
+		m["ack"] = binding.Ack
+	}
+	if len(binding.BindingVersion) != 0 {
+		m["bindingVersion"] = binding.BindingVersion
+	}
+
+	return json.Marshal(m)
+}
+
+func (binding *AmqpOperation) UnmarshalJSON(data []byte) error {
+	type AmqpOperationBis AmqpOperation
+	var x AmqpOperationBis
+	if err := json.Unmarshal(data, &x); err != nil {
+		return err
+	}
+	_ = json.Unmarshal(data, &x.Extensions)
+
+	delete(x.Extensions, "expiration")
+	delete(x.Extensions, "userId")
+	delete(x.Extensions, "cc")
+	delete(x.Extensions, "priority")
+	delete(x.Extensions, "deliveryMode")
+	delete(x.Extensions, "mandatory")
+	delete(x.Extensions, "bcc")
+	delete(x.Extensions, "replyTo")
+	delete(x.Extensions, "timestamp")
+	delete(x.Extensions, "ack")
+	delete(x.Extensions, "bindingVersion")
+
+	*binding = AmqpOperation(x)
+
+	return nil
+}
+
+func (binding *AmqpOperation) Validate(ctx context.Context) error {
+	if err := validate.Extensions(ctx, binding.Extensions); err != nil {
+		return err
+	}
+
+	err := validateVersionedFields(ctx, "amqp", binding.BindingVersion, []versionedField{
+		{name: "replyTo", removed: "0.3.0", set: len(binding.ReplyTo) != 0},
+	})
+	if err != nil {
+		return err
+	}
+
+	if v := binding.Expiration; v != nil && *v < 0 {
+		err := fmt.Errorf("expiration must be greater than or equal to 0: %w", validate.ErrWrongField)
+		if err := validate.Report(validate.At(ctx, "expiration"), validate.RuleValue, err); err != nil {
+			return err
+		}
+	}
+
+	if v := binding.Priority; v != nil && *v < 0 {
+		err := fmt.Errorf("priority must be greater than or equal to 0: %w", validate.ErrWrongField)
+		if err := validate.Report(validate.At(ctx, "priority"), validate.RuleValue, err); err != nil {
+			return err
+		}
+	}
+
+	switch binding.DeliveryMode {
+	case 0, AmqpDeliveryModeTransient, AmqpDeliveryModePersistent:
+	default:
+		err := fmt.Errorf("deliveryMode must be either 1 or 2: %w", validate.ErrWrongField)
+		if err := validate.Report(validate.At(ctx, "deliveryMode"), validate.RuleValue, err); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AmqpMessage is defined in AsyncAPI spec: https://github.com/asyncapi/bindings/tree/master/amqp#message-binding-object
+type AmqpMessage struct {
+	Extensions map[string]interface{} `json:"-" yaml:"-"`
+
+	ContentEncoding string `json:"contentEncoding,omitempty" yaml:"contentEncoding,omitempty"`
+	MessageType     string `json:"messageType,omitempty" yaml:"messageType,omitempty"`
+	BindingVersion  string `json:"bindingVersion,omitempty" yaml:"bindingVersion,omitempty"`
+}
+
+func (value *AmqpMessage) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{}, 3+len(value.Extensions))
+	for k, v := range value.Extensions {
+		m[k] = v
+	}
+
+	if len(value.ContentEncoding) != 0 {
+		m["contentEncoding"] = value.ContentEncoding
+	}
+	if len(value.MessageType) != 0 {
+		m["messageType"] = value.MessageType
+	}
+	if len(value.BindingVersion) != 0 {
+		m["bindingVersion"] = value.BindingVersion
+	}
+
+	return json.Marshal(m)
+}
+
+func (value *AmqpMessage) UnmarshalJSON(data []byte) error {
+	type AmqpMessageBis AmqpMessage
+	var x AmqpMessageBis
+	if err := json.Unmarshal(data, &x); err != nil {
+		return err
+	}
+	_ = json.Unmarshal(data, &x.Extensions)
+
+	delete(x.Extensions, "contentEncoding")
+	delete(x.Extensions, "messageType")
+	delete(x.Extensions, "bindingVersion")
+
+	*value = AmqpMessage(x)
+
+	return nil
+}
+
+func (value *AmqpMessage) Validate(ctx context.Context) error {
+	if err := validate.Extensions(ctx, value.Extensions); err != nil {
+		return err
+	}
+
+	if err := validateBindingVersion(ctx, "amqp", value.BindingVersion); err != nil {
+		return err
+	}
+
+	return nil
+}
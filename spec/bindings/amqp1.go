@@ -0,0 +1,196 @@
+package bindings
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/rdmrcv/go-asyncapi2/spec/validate"
+)
+
+// Amqp1Server is defined in AsyncAPI spec: https://github.com/asyncapi/bindings/tree/master/amqp1#server-binding-object
+type Amqp1Server struct {
+	Extensions map[string]interface{} `json:"-" yaml:"-"`
+
+	BindingVersion string `json:"bindingVersion,omitempty" yaml:"bindingVersion,omitempty"`
+}
+
+func (binding *Amqp1Server) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{}, 1+len(binding.Extensions))
+	for k, v := range binding.Extensions {
+		m[k] = v
+	}
+
+	if len(binding.BindingVersion) != 0 {
+		m["bindingVersion"] = binding.BindingVersion
+	}
+
+	return json.Marshal(m)
+}
+
+func (binding *Amqp1Server) UnmarshalJSON(data []byte) error {
+	type Amqp1ServerBis Amqp1Server
+	var x Amqp1ServerBis
+	if err := json.Unmarshal(data, &x); err != nil {
+		return err
+	}
+	_ = json.Unmarshal(data, &x.Extensions)
+
+	delete(x.Extensions, "bindingVersion")
+
+	*binding = Amqp1Server(x)
+
+	return nil
+}
+
+func (binding *Amqp1Server) Validate(ctx context.Context) error {
+	if err := validate.Extensions(ctx, binding.Extensions); err != nil {
+		return err
+	}
+
+	if err := validateBindingVersion(ctx, "amqp1", binding.BindingVersion); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Amqp1Channel is defined in AsyncAPI spec: https://github.com/asyncapi/bindings/tree/master/amqp1#channel-binding-object
+type Amqp1Channel struct {
+	Extensions map[string]interface{} `json:"-" yaml:"-"`
+
+	BindingVersion string `json:"bindingVersion,omitempty" yaml:"bindingVersion,omitempty"`
+}
+
+func (binding *Amqp1Channel) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{}, 1+len(binding.Extensions))
+	for k, v := range binding.Extensions {
+		m[k] = v
+	}
+
+	if len(binding.BindingVersion) != 0 {
+		m["bindingVersion"] = binding.BindingVersion
+	}
+
+	return json.Marshal(m)
+}
+
+func (binding *Amqp1Channel) UnmarshalJSON(data []byte) error {
+	type Amqp1ChannelBis Amqp1Channel
+	var x Amqp1ChannelBis
+	if err := json.Unmarshal(data, &x); err != nil {
+		return err
+	}
+	_ = json.Unmarshal(data, &x.Extensions)
+
+	delete(x.Extensions, "bindingVersion")
+
+	*binding = Amqp1Channel(x)
+
+	return nil
+}
+
+func (binding *Amqp1Channel) Validate(ctx context.Context) error {
+	if err := validate.Extensions(ctx, binding.Extensions); err != nil {
+		return err
+	}
+
+	if err := validateBindingVersion(ctx, "amqp1", binding.BindingVersion); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Amqp1Operation is defined in AsyncAPI spec: https://github.com/asyncapi/bindings/tree/master/amqp1#operation-binding-object
+type Amqp1Operation struct {
+	Extensions map[string]interface{} `json:"-" yaml:"-"`
+
+	BindingVersion string `json:"bindingVersion,omitempty" yaml:"bindingVersion,omitempty"`
+}
+
+func (binding *Amqp1Operation) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{}, 1+len(binding.Extensions))
+	for k, v := range binding.Extensions {
+		m[k] = v
+	}
+
+	if len(binding.BindingVersion) != 0 {
+		m["bindingVersion"] = binding.BindingVersion
+	}
+
+	return json.Marshal(m)
+}
+
+func (binding *Amqp1Operation) UnmarshalJSON(data []byte) error {
+	type Amqp1OperationBis Amqp1Operation
+	var x Amqp1OperationBis
+	if err := json.Unmarshal(data, &x); err != nil {
+		return err
+	}
+	_ = json.Unmarshal(data, &x.Extensions)
+
+	delete(x.Extensions, "bindingVersion")
+
+	*binding = Amqp1Operation(x)
+
+	return nil
+}
+
+func (binding *Amqp1Operation) Validate(ctx context.Context) error {
+	if err := validate.Extensions(ctx, binding.Extensions); err != nil {
+		return err
+	}
+
+	if err := validateBindingVersion(ctx, "amqp1", binding.BindingVersion); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Amqp1Message is defined in AsyncAPI spec: https://github.com/asyncapi/bindings/tree/master/amqp1#message-binding-object
+type Amqp1Message struct {
+	Extensions map[string]interface{} `json:"-" yaml:"-"`
+
+	BindingVersion string `json:"bindingVersion,omitempty" yaml:"bindingVersion,omitempty"`
+}
+
+func (binding *Amqp1Message) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{}, 1+len(binding.Extensions))
+	for k, v := range binding.Extensions {
+		m[k] = v
+	}
+
+	if len(binding.BindingVersion) != 0 {
+		m["bindingVersion"] = binding.BindingVersion
+	}
+
+	return json.Marshal(m)
+}
+
+func (binding *Amqp1Message) UnmarshalJSON(data []byte) error {
+	type Amqp1MessageBis Amqp1Message
+	var x Amqp1MessageBis
+	if err := json.Unmarshal(data, &x); err != nil {
+		return err
+	}
+	_ = json.Unmarshal(data, &x.Extensions)
+
+	delete(x.Extensions, "bindingVersion")
+
+	*binding = Amqp1Message(x)
+
+	return nil
+}
+
+func (binding *Amqp1Message) Validate(ctx context.Context) error {
+	if err := validate.Extensions(ctx, binding.Extensions); err != nil {
+		return err
+	}
+
+	if err := validateBindingVersion(ctx, "amqp1", binding.BindingVersion); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,18 @@
+package bindings
+
+import (
+	"testing"
+)
+
+func TestAmqp1Bindings_UnmarshalJSON(t *testing.T) {
+	data := []byte(`{"bindingVersion": "0.1.0", "x-container": "orders"}`)
+
+	bindings := []interface{}{&Amqp1Server{}, &Amqp1Channel{}, &Amqp1Operation{}, &Amqp1Message{}}
+	for _, binding := range bindings {
+		checkRoundTrip(t, data, binding)
+	}
+
+	if v := bindings[0].(*Amqp1Server); v.BindingVersion != "0.1.0" || v.Extensions["x-container"] != "orders" {
+		t.Errorf("unexpected binding %+v", v)
+	}
+}
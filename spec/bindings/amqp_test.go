@@ -0,0 +1,126 @@
+package bindings
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/rdmrcv/go-asyncapi2/spec/validate"
+)
+
+func TestAmqpChannel_UnmarshalJSON(t *testing.T) {
+	data := []byte(`{
+		"is": "routingKey",
+		"exchange": {"name": "myExchange", "type": "topic", "durable": false, "autoDelete": false, "vhost": "/"},
+		"bindingVersion": "0.2.0",
+		"x-internal": true
+	}`)
+
+	var binding AmqpChannel
+	if err := json.Unmarshal(data, &binding); err != nil {
+		t.Fatal(err)
+	}
+
+	if binding.Is != AmqpChannelIsRoutingKey || binding.Exchange.Type != AmqpExchangeTopic {
+		t.Errorf("unexpected binding %+v", binding)
+	}
+
+	if binding.Exchange.Durable == nil || *binding.Exchange.Durable {
+		t.Error("expected durable to be set to false")
+	}
+
+	if binding.Extensions["x-internal"] != true || len(binding.Extensions) != 1 {
+		t.Errorf("unexpected extensions %v", binding.Extensions)
+	}
+
+	out, err := json.Marshal(&binding)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var expected, actual interface{}
+	_ = json.Unmarshal(data, &expected)
+	_ = json.Unmarshal(out, &actual)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("expected %s, got %s", data, out)
+	}
+}
+
+func TestAmqpServer_UnmarshalJSON(t *testing.T) {
+	data := []byte(`{"bindingVersion": "0.3.0", "x-internal": true}`)
+
+	var binding AmqpServer
+	if err := json.Unmarshal(data, &binding); err != nil {
+		t.Fatal(err)
+	}
+
+	if binding.BindingVersion != "0.3.0" || binding.Extensions["x-internal"] != true || len(binding.Extensions) != 1 {
+		t.Errorf("unexpected binding %+v", binding)
+	}
+
+	out, err := json.Marshal(&binding)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var expected, actual interface{}
+	_ = json.Unmarshal(data, &expected)
+	_ = json.Unmarshal(out, &actual)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("expected %s, got %s", data, out)
+	}
+}
+
+func TestAmqpBindings_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		binding interface{ Validate(context.Context) error }
+		pointer string
+	}{
+		{
+			name:    "valid channel",
+			binding: &AmqpChannel{Is: AmqpChannelIsQueue, Queue: &AmqpQueue{Name: "my-queue"}},
+		},
+		{
+			name:    "unknown is",
+			binding: &AmqpChannel{Is: "topic"},
+			pointer: "/is",
+		},
+		{
+			name:    "unknown exchange type",
+			binding: &AmqpChannel{Exchange: &AmqpExchange{Type: "fan"}},
+			pointer: "/exchange/type",
+		},
+		{
+			name:    "valid operation",
+			binding: &AmqpOperation{DeliveryMode: AmqpDeliveryModePersistent},
+		},
+		{
+			name:    "unknown delivery mode",
+			binding: &AmqpOperation{DeliveryMode: 3},
+			pointer: "/deliveryMode",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validate.Collect(context.Background(), tt.binding.Validate)
+			if tt.pointer == "" {
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				return
+			}
+
+			var errs validate.Errors
+			if !errors.As(err, &errs) || len(errs) != 1 || errs[0].Pointer != tt.pointer {
+				t.Fatalf("expected error at %s, got %v", tt.pointer, err)
+			}
+		})
+	}
+}
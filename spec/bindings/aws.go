@@ -0,0 +1,164 @@
+package bindings
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/rdmrcv/go-asyncapi2/spec/validate"
+)
+
+type AwsPolicyEffect string
+
+const (
+	AwsPolicyAllow AwsPolicyEffect = "Allow"
+	AwsPolicyDeny  AwsPolicyEffect = "Deny"
+)
+
+// AwsPolicy is the access policy of a topic or a queue.
+type AwsPolicy struct {
+	Extensions map[string]interface{} `json:"-" yaml:"-"`
+
+	Statements []*AwsPolicyStatement `json:"statements,omitempty" yaml:"statements,omitempty"`
+}
+
+func (value *AwsPolicy) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{}, 1+len(value.Extensions))
+	for k, v := range value.Extensions {
+		m[k] = v
+	}
+
+	if len(value.Statements) != 0 {
+		m["statements"] = value.Statements
+	}
+
+	return json.Marshal(m)
+}
+
+func (value *AwsPolicy) UnmarshalJSON(data []byte) error {
+	type AwsPolicyBis AwsPolicy
+	var x AwsPolicyBis
+	if err := json.Unmarshal(data, &x); err != nil {
+		return err
+	}
+	_ = json.Unmarshal(data, &x.Extensions)
+
+	delete(x.Extensions, "statements")
+
+	*value = AwsPolicy(x)
+
+	return nil
+}
+
+func (value *AwsPolicy) Validate(ctx context.Context) error {
+	if err := validate.Extensions(ctx, value.Extensions); err != nil {
+		return err
+	}
+
+	for i, v := range value.Statements {
+		if v == nil {
+			continue
+		}
+
+		if err := v.Validate(validate.At(ctx, "statements", strconv.Itoa(i))); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AwsPolicyStatement allows or denies principals the actions.
+type AwsPolicyStatement struct {
+	Extensions map[string]interface{} `json:"-" yaml:"-"`
+
+	Effect    AwsPolicyEffect `json:"effect,omitempty" yaml:"effect,omitempty"`
+	Principal interface{}     `json:"principal,omitempty" yaml:"principal,omitempty"`
+	Action    interface{}     `json:"action,omitempty" yaml:"action,omitempty"`
+}
+
+func (value *AwsPolicyStatement) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{}, 3+len(value.Extensions))
+	for k, v := range value.Extensions {
+		m[k] = v
+	}
+
+	if len(value.Effect) != 0 {
+		m["effect"] = value.Effect
+	}
+	if value.Principal != nil {
+		m["principal"] = value.Principal
+	}
+	if value.Action != nil {
+		m["action"] = value.Action
+	}
+
+	return json.Marshal(m)
+}
+
+func (value *AwsPolicyStatement) UnmarshalJSON(data []byte) error {
+	type AwsPolicyStatementBis AwsPolicyStatement
+	var x AwsPolicyStatementBis
+	if err := json.Unmarshal(data, &x); err != nil {
+		return err
+	}
+	_ = json.Unmarshal(data, &x.Extensions)
+
+	delete(x.Extensions, "effect")
+	delete(x.Extensions, "principal")
+	delete(x.Extensions, "action")
+
+	*value = AwsPolicyStatement(x)
+
+	return nil
+}
+
+func (value *AwsPolicyStatement) Validate(ctx context.Context) error {
+	if err := validate.Extensions(ctx, value.Extensions); err != nil {
+		return err
+	}
+
+	if value.Effect != AwsPolicyAllow && value.Effect != AwsPolicyDeny {
+		err := fmt.Errorf("effect must be either Allow or Deny: %w", validate.ErrWrongField)
+		if err := validate.Report(validate.At(ctx, "effect"), validate.RuleValue, err); err != nil {
+			return err
+		}
+	}
+
+	if value.Principal == nil {
+		err := fmt.Errorf("field principal is required: %w", validate.ErrWrongField)
+		if err := validate.Report(validate.At(ctx, "principal"), validate.RuleRequired, err); err != nil {
+			return err
+		}
+	}
+
+	if !isAwsStrings(value.Action) {
+		err := fmt.Errorf("action must be a string or an array of strings: %w", validate.ErrWrongField)
+		if err := validate.Report(validate.At(ctx, "action"), validate.RuleValue, err); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// isAwsStrings reports whether the value is a string or a non-empty array of strings.
+func isAwsStrings(value interface{}) bool {
+	switch v := value.(type) {
+	case string:
+		return v != ""
+	case []string:
+		return len(v) != 0
+	case []interface{}:
+		for _, item := range v {
+			if _, ok := item.(string); !ok {
+				return false
+			}
+		}
+
+		return len(v) != 0
+	}
+
+	return false
+}
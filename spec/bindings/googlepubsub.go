@@ -0,0 +1,332 @@
+package bindings
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/rdmrcv/go-asyncapi2/spec/validate"
+)
+
+type GooglePubSubEncoding string
+
+const (
+	GooglePubSubEncodingJSON   GooglePubSubEncoding = "JSON"
+	GooglePubSubEncodingBinary GooglePubSubEncoding = "BINARY"
+)
+
+// GooglePubSubChannel is defined in AsyncAPI spec: https://github.com/asyncapi/bindings/tree/master/googlepubsub#channel-binding-object
+type GooglePubSubChannel struct {
+	Extensions map[string]interface{} `json:"-" yaml:"-"`
+
+	Topic                    string                            `json:"topic,omitempty" yaml:"topic,omitempty"`
+	Labels                   map[string]string                 `json:"labels,omitempty" yaml:"labels,omitempty"`
+	MessageRetentionDuration string                            `json:"messageRetentionDuration,omitempty" yaml:"messageRetentionDuration,omitempty"`
+	MessageStoragePolicy     *GooglePubSubMessageStoragePolicy `json:"messageStoragePolicy,omitempty" yaml:"messageStoragePolicy,omitempty"`
+	SchemaSettings           *GooglePubSubSchemaSettings       `json:"schemaSettings,omitempty" yaml:"schemaSettings,omitempty"`
+	BindingVersion           string                            `json:"bindingVersion,omitempty" yaml:"bindingVersion,omitempty"`
+}
+
+func (binding *GooglePubSubChannel) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{}, 6+len(binding.Extensions))
+	for k, v := range binding.Extensions {
+		m[k] = v
+	}
+
+	if len(binding.Topic) != 0 {
+		m["topic"] = binding.Topic
+	}
+	if len(binding.Labels) != 0 {
+		m["labels"] = binding.Labels
+	}
+	if len(binding.MessageRetentionDuration) != 0 {
+		m["messageRetentionDuration"] = binding.MessageRetentionDuration
+	}
+	if binding.MessageStoragePolicy != nil {
+		m["messageStoragePolicy"] = binding.MessageStoragePolicy
+	}
+	if binding.SchemaSettings != nil {
+		m["schemaSettings"] = binding.SchemaSettings
+	}
+	if len(binding.BindingVersion) != 0 {
+		m["bindingVersion"] = binding.BindingVersion
+	}
+
+	return json.Marshal(m)
+}
+
+func (binding *GooglePubSubChannel) UnmarshalJSON(data []byte) error {
+	type GooglePubSubChannelBis GooglePubSubChannel
+	var x GooglePubSubChannelBis
+	if err := json.Unmarshal(data, &x); err != nil {
+		return err
+	}
+	_ = json.Unmarshal(data, &x.Extensions)
+
+	delete(x.Extensions, "topic")
+	delete(x.Extensions, "labels")
+	delete(x.Extensions, "messageRetentionDuration")
+	delete(x.Extensions, "messageStoragePolicy")
+	delete(x.Extensions, "schemaSettings")
+	delete(x.Extensions, "bindingVersion")
+
+	*binding = GooglePubSubChannel(x)
+
+	return nil
+}
+
+func (binding *GooglePubSubChannel) Validate(ctx context.Context) error {
+	if err := validate.Extensions(ctx, binding.Extensions); err != nil {
+		return err
+	}
+
+	err := validateVersionedFields(ctx, "googlepubsub", binding.BindingVersion, []versionedField{
+		{name: "topic", removed: "0.2.0", set: len(binding.Topic) != 0},
+	})
+	if err != nil {
+		return err
+	}
+
+	if v := binding.MessageStoragePolicy; v != nil {
+		if err := validate.Extensions(validate.At(ctx, "messageStoragePolicy"), v.Extensions); err != nil {
+			return err
+		}
+	}
+
+	if v := binding.SchemaSettings; v != nil {
+		if err := v.Validate(validate.At(ctx, "schemaSettings")); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GooglePubSubMessageStoragePolicy lists regions where messages of the topic may be stored.
+type GooglePubSubMessageStoragePolicy struct {
+	Extensions map[string]interface{} `json:"-" yaml:"-"`
+
+	AllowedPersistenceRegions []string `json:"allowedPersistenceRegions,omitempty" yaml:"allowedPersistenceRegions,omitempty"`
+}
+
+func (value *GooglePubSubMessageStoragePolicy) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{}, 1+len(value.Extensions))
+	for k, v := range value.Extensions {
+		m[k] = v
+	}
+
+	if len(value.AllowedPersistenceRegions) != 0 {
+		m["allowedPersistenceRegions"] = value.AllowedPersistenceRegions
+	}
+
+	return json.Marshal(m)
+}
+
+func (value *GooglePubSubMessageStoragePolicy) UnmarshalJSON(data []byte) error {
+	type GooglePubSubMessageStoragePolicyBis GooglePubSubMessageStoragePolicy
+	var x GooglePubSubMessageStoragePolicyBis
+	if err := json.Unmarshal(data, &x); err != nil {
+		return err
+	}
+	_ = json.Unmarshal(data, &x.Extensions)
+
+	delete(x.Extensions, "allowedPersistenceRegions")
+
+	*value = GooglePubSubMessageStoragePolicy(x)
+
+	return nil
+}
+
+// GooglePubSubSchemaSettings describes the schema messages of the topic are validated against.
+type GooglePubSubSchemaSettings struct {
+	Extensions map[string]interface{} `json:"-" yaml:"-"`
+
+	Encoding        GooglePubSubEncoding `json:"encoding,omitempty" yaml:"encoding,omitempty"`
+	FirstRevisionID string               `json:"firstRevisionId,omitempty" yaml:"firstRevisionId,omitempty"`
+	LastRevisionID  string               `json:"lastRevisionId,omitempty" yaml:"lastRevisionId,omitempty"`
+	Name            string               `json:"name,omitempty" yaml:"name,omitempty"`
+}
+
+func (value *GooglePubSubSchemaSettings) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{}, 4+len(value.Extensions))
+	for k, v := range value.Extensions {
+		m[k] = v
+	}
+
+	if len(value.Encoding) != 0 {
+		m["encoding"] = value.Encoding
+	}
+	if len(value.FirstRevisionID) != 0 {
+		m["firstRevisionId"] = value.FirstRevisionID
+	}
+	if len(value.LastRevisionID) != 0 {
+		m["lastRevisionId"] = value.LastRevisionID
+	}
+	if len(value.Name) != 0 {
+		m["name"] = value.Name
+	}
+
+	return json.Marshal(m)
+}
+
+func (value *GooglePubSubSchemaSettings) UnmarshalJSON(data []byte) error {
+	type GooglePubSubSchemaSettingsBis GooglePubSubSchemaSettings
+	var x GooglePubSubSchemaSettingsBis
+	if err := json.Unmarshal(data, &x); err != nil {
+		return err
+	}
+	_ = json.Unmarshal(data, &x.Extensions)
+
+	delete(x.Extensions, "encoding")
+	delete(x.Extensions, "firstRevisionId")
+	delete(x.Extensions, "lastRevisionId")
+	delete(x.Extensions, "name")
+
+	*value = GooglePubSubSchemaSettings(x)
+
+	return nil
+}
+
+func (value *GooglePubSubSchemaSettings) Validate(ctx context.Context) error {
+	if err := validate.Extensions(ctx, value.Extensions); err != nil {
+		return err
+	}
+
+	switch value.Encoding {
+	case GooglePubSubEncodingJSON, GooglePubSubEncodingBinary:
+	default:
+		err := fmt.Errorf("encoding must be either JSON or BINARY: %w", validate.ErrWrongField)
+		if err := validate.Report(validate.At(ctx, "encoding"), validate.RuleValue, err); err != nil {
+			return err
+		}
+	}
+
+	if value.Name == "" {
+		err := fmt.Errorf("value of name must be a non-empty string: %w", validate.ErrWrongField)
+		if err := validate.Report(validate.At(ctx, "name"), validate.RuleRequired, err); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GooglePubSubMessage is defined in AsyncAPI spec: https://github.com/asyncapi/bindings/tree/master/googlepubsub#message-binding-object
+type GooglePubSubMessage struct {
+	Extensions map[string]interface{} `json:"-" yaml:"-"`
+
+	Attributes     map[string]interface{}     `json:"attributes,omitempty" yaml:"attributes,omitempty"`
+	OrderingKey    string                     `json:"orderingKey,omitempty" yaml:"orderingKey,omitempty"`
+	Schema         *GooglePubSubMessageSchema `json:"schema,omitempty" yaml:"schema,omitempty"`
+	BindingVersion string                     `json:"bindingVersion,omitempty" yaml:"bindingVersion,omitempty"`
+}
+
+func (value *GooglePubSubMessage) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{}, 4+len(value.Extensions))
+	for k, v := range value.Extensions {
+		m[k] = v
+	}
+
+	if len(value.Attributes) != 0 {
+		m["attributes"] = value.Attributes
+	}
+	if len(value.OrderingKey) != 0 {
+		m["orderingKey"] = value.OrderingKey
+	}
+	if value.Schema != nil {
+		m["schema"] = value.Schema
+	}
+	if len(value.BindingVersion) != 0 {
+		m["bindingVersion"] = value.BindingVersion
+	}
+
+	return json.Marshal(m)
+}
+
+func (value *GooglePubSubMessage) UnmarshalJSON(data []byte) error {
+	type GooglePubSubMessageBis GooglePubSubMessage
+	var x GooglePubSubMessageBis
+	if err := json.Unmarshal(data, &x); err != nil {
+		return err
+	}
+	_ = json.Unmarshal(data, &x.Extensions)
+
+	delete(x.Extensions, "attributes")
+	delete(x.Extensions, "orderingKey")
+	delete(x.Extensions, "schema")
+	delete(x.Extensions, "bindingVersion")
+
+	*value = GooglePubSubMessage(x)
+
+	return nil
+}
+
+func (value *GooglePubSubMessage) Validate(ctx context.Context) error {
+	if err := validate.Extensions(ctx, value.Extensions); err != nil {
+		return err
+	}
+
+	err := validateVersionedFields(ctx, "googlepubsub", value.BindingVersion, []versionedField{
+		{name: "schema/type", removed: "0.2.0", set: value.Schema != nil && len(value.Schema.Type) != 0},
+	})
+	if err != nil {
+		return err
+	}
+
+	if v := value.Schema; v != nil {
+		ctx := validate.At(ctx, "schema")
+
+		if err := validate.Extensions(ctx, v.Extensions); err != nil {
+			return err
+		}
+
+		if v.Name == "" {
+			err := fmt.Errorf("value of name must be a non-empty string: %w", validate.ErrWrongField)
+			if err := validate.Report(validate.At(ctx, "name"), validate.RuleRequired, err); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// GooglePubSubMessageSchema names the schema of the message.
+type GooglePubSubMessageSchema struct {
+	Extensions map[string]interface{} `json:"-" yaml:"-"`
+
+	Name string `json:"name,omitempty" yaml:"name,omitempty"`
+	Type string `json:"type,omitempty" yaml:"type,omitempty"`
+}
+
+func (value *GooglePubSubMessageSchema) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{}, 2+len(value.Extensions))
+	for k, v := range value.Extensions {
+		m[k] = v
+	}
+
+	if len(value.Name) != 0 {
+		m["name"] = value.Name
+	}
+	if len(value.Type) != 0 {
+		m["type"] = value.Type
+	}
+
+	return json.Marshal(m)
+}
+
+func (value *GooglePubSubMessageSchema) UnmarshalJSON(data []byte) error {
+	type GooglePubSubMessageSchemaBis GooglePubSubMessageSchema
+	var x GooglePubSubMessageSchemaBis
+	if err := json.Unmarshal(data, &x); err != nil {
+		return err
+	}
+	_ = json.Unmarshal(data, &x.Extensions)
+
+	delete(x.Extensions, "name")
+	delete(x.Extensions, "type")
+
+	*value = GooglePubSubMessageSchema(x)
+
+	return nil
+}
@@ -0,0 +1,82 @@
+package bindings
+
+import (
+	"context"
+	"testing"
+)
+
+func TestGooglePubSubBindings_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		binding interface{}
+	}{
+		{
+			name: "channel",
+			data: `{
+				"labels": {"team": "orders"},
+				"messageRetentionDuration": "86400s",
+				"messageStoragePolicy": {"allowedPersistenceRegions": ["europe-west1"], "x-reason": "gdpr"},
+				"schemaSettings": {"encoding": "JSON", "name": "projects/orders/schemas/order", "x-owner": "sales"},
+				"bindingVersion": "0.2.0",
+				"x-project": "orders"
+			}`,
+			binding: &GooglePubSubChannel{},
+		},
+		{
+			name:    "message",
+			data:    `{"attributes": {"origin": "web"}, "orderingKey": "customer", "schema": {"name": "projects/orders/schemas/order", "x-owner": "sales"}, "bindingVersion": "0.2.0", "x-project": "orders"}`,
+			binding: &GooglePubSubMessage{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checkRoundTrip(t, []byte(tt.data), tt.binding)
+		})
+	}
+}
+
+func TestGooglePubSubBindings_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		binding  interface{ Validate(context.Context) error }
+		pointers []string
+	}{
+		{
+			name: "channel",
+			binding: &GooglePubSubChannel{SchemaSettings: &GooglePubSubSchemaSettings{
+				Encoding: GooglePubSubEncodingBinary,
+				Name:     "projects/orders/schemas/order",
+			}},
+		},
+		{
+			name:     "schemaSettings without encoding and name",
+			binding:  &GooglePubSubChannel{SchemaSettings: &GooglePubSubSchemaSettings{}},
+			pointers: []string{"/schemaSettings/encoding", "/schemaSettings/name"},
+		},
+		{
+			name: "unknown schemaSettings encoding",
+			binding: &GooglePubSubChannel{SchemaSettings: &GooglePubSubSchemaSettings{
+				Encoding: "json",
+				Name:     "projects/orders/schemas/order",
+			}},
+			pointers: []string{"/schemaSettings/encoding"},
+		},
+		{
+			name:    "message",
+			binding: &GooglePubSubMessage{Schema: &GooglePubSubMessageSchema{Name: "projects/orders/schemas/order"}},
+		},
+		{
+			name:     "message schema without name",
+			binding:  &GooglePubSubMessage{Schema: &GooglePubSubMessageSchema{}},
+			pointers: []string{"/schema/name"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checkValidate(t, tt.binding, tt.pointers)
+		})
+	}
+}
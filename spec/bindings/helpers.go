@@ -0,0 +1,30 @@
+package bindings
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rdmrcv/go-asyncapi2/spec/validate"
+)
+
+// validateRange checks that the value, when set, is in the range.
+func validateRange(ctx context.Context, value *int, min, max int) error {
+	if value == nil || *value >= min && *value <= max {
+		return nil
+	}
+
+	err := fmt.Errorf("value must be between %d and %d: %w", min, max, validate.ErrWrongField)
+
+	return validate.Report(ctx, validate.RuleValue, err)
+}
+
+// validateMaxLength checks that the value is at most max characters long.
+func validateMaxLength(ctx context.Context, value string, max int) error {
+	if len(value) <= max {
+		return nil
+	}
+
+	err := fmt.Errorf("value must be at most %d characters: %w", max, validate.ErrWrongField)
+
+	return validate.Report(ctx, validate.RuleValue, err)
+}
@@ -0,0 +1,55 @@
+package bindings
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/rdmrcv/go-asyncapi2/spec/validate"
+)
+
+// checkRoundTrip decodes the data into the binding and checks that it is encoded back into the same JSON.
+func checkRoundTrip(t *testing.T, data []byte, binding interface{}) {
+	t.Helper()
+
+	if err := json.Unmarshal(data, binding); err != nil {
+		t.Fatal(err)
+	}
+
+	out, err := json.Marshal(binding)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var expected, actual interface{}
+	_ = json.Unmarshal(data, &expected)
+	_ = json.Unmarshal(out, &actual)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("expected %s, got %s", data, out)
+	}
+}
+
+// checkValidate collects errors of the binding and checks they are reported at the pointers.
+func checkValidate(t *testing.T, binding interface{ Validate(context.Context) error }, pointers []string) {
+	t.Helper()
+
+	err := validate.Collect(context.Background(), binding.Validate)
+
+	var errs validate.Errors
+	if err != nil && !errors.As(err, &errs) {
+		t.Fatalf("expected validate.Errors, got %v", err)
+	}
+
+	if len(errs) != len(pointers) {
+		t.Fatalf("expected errors at %v, got %v", pointers, err)
+	}
+
+	for i, v := range pointers {
+		if errs[i].Pointer != v {
+			t.Errorf("expected error at %s, got %s", v, errs[i].Pointer)
+		}
+	}
+}
@@ -34,6 +34,13 @@ const (
 	HttpOperationBindingResponse = "response"
 )
 
+const (
+	// httpOperationTypeRemoved is the binding version operations have no type since.
+	httpOperationTypeRemoved = "0.2.0"
+	// httpMessageStatusCodeAdded is the binding version messages have status codes since.
+	httpMessageStatusCodeAdded = "0.3.0"
+)
+
 var httpValidMethodsSet = map[string]struct{}{
 	http.MethodGet:     {},
 	http.MethodPost:    {},
@@ -86,42 +93,86 @@ func (binding *HttpOperation) UnmarshalJSON(data []byte) error {
 	}
 	_ = json.Unmarshal(data, &x.Extensions)
 
-	delete(x.Extensions, "type")
 	delete(x.Extensions, "method")
 	delete(x.Extensions, "query")
 	delete(x.Extensions, "bindingVersion")
 
+	// Operations have no type since 0.2.0, so it stays in extensions.
+	if httpOperationHasType(x.BindingVersion, x.Type) {
+		delete(x.Extensions, "type")
+	} else {
+		x.Type = ""
+	}
+
 	*binding = HttpOperation(x)
 
 	return nil
 }
 
 func (binding *HttpOperation) Validate(ctx context.Context) error {
-	switch binding.Type {
-	case HttpOperationBindingRequest:
-		if _, ok := httpValidMethodsSet[binding.Method]; !ok {
-			return fmt.Errorf(
-				"when the type field is request the mehtod should be set to a valid value: %w",
+	if err := validate.Extensions(ctx, binding.Extensions); err != nil {
+		return err
+	}
+
+	if err := validateBindingVersion(ctx, "http", binding.BindingVersion); err != nil {
+		return err
+	}
+
+	if httpOperationHasType(binding.BindingVersion, binding.Type) {
+		switch binding.Type {
+		case HttpOperationBindingRequest:
+			if _, ok := httpValidMethodsSet[binding.Method]; !ok {
+				err := fmt.Errorf(
+					"when the type field is request the mehtod should be set to a valid value: %w",
+					validate.ErrWrongField,
+				)
+				if err := validate.Report(validate.At(ctx, "method"), validate.RuleValue, err); err != nil {
+					return err
+				}
+			}
+		case HttpOperationBindingResponse:
+		default:
+			err := fmt.Errorf(
+				"type should be set and must be either request or response: %w",
+				validate.ErrWrongField,
+			)
+			if err := validate.Report(validate.At(ctx, "type"), validate.RuleValue, err); err != nil {
+				return err
+			}
+		}
+	} else {
+		if binding.Type != "" {
+			err := fmt.Errorf(
+				"type is defined up to binding version 0.1.0, upgrade the binding to remove it: %w",
 				validate.ErrWrongField,
 			)
+			if err := validate.Report(validate.At(ctx, "type"), validate.RuleValue, err); err != nil {
+				return err
+			}
+		}
+
+		if _, ok := httpValidMethodsSet[binding.Method]; binding.Method != "" && !ok {
+			err := fmt.Errorf("method should be set to a valid value: %w", validate.ErrWrongField)
+			if err := validate.Report(validate.At(ctx, "method"), validate.RuleValue, err); err != nil {
+				return err
+			}
 		}
-	case HttpOperationBindingResponse:
-	default:
-		return fmt.Errorf(
-			"type should be set and must be either request or response: %w",
-			validate.ErrWrongField,
-		)
 	}
 
 	if v := binding.Query; v != nil {
+		ctx := validate.At(ctx, "query")
+
 		if !v.Type.Is(openapi3.TypeObject) || len(v.Properties) == 0 {
-			return fmt.Errorf(
+			err := fmt.Errorf(
 				"the schema in the query field MUST be of type object and have a properties key: %w",
 				validate.ErrWrongField,
 			)
+			if err := validate.Report(ctx, validate.RuleSchema, err); err != nil {
+				return err
+			}
 		}
 
-		if err := v.Validate(ctx); err != nil {
+		if err := validate.Schema(ctx, v); err != nil {
 			return err
 		}
 	}
@@ -129,16 +180,49 @@ func (binding *HttpOperation) Validate(ctx context.Context) error {
 	return nil
 }
 
+// httpOperationHasType reports whether operations of the version have a type.
+// Operations without the version are taken as ones of 0.1.0 when they have the type, as documents written
+// before the version was introduced do.
+func httpOperationHasType(version string, typ HttpOperationBindingType) bool {
+	if version == "" {
+		return typ != ""
+	}
+
+	return compareBindingVersions(version, httpOperationTypeRemoved) < 0
+}
+
+// Upgrade rewrites the binding into the shape of the latest version. The type of 0.1.0 is removed,
+// also from extensions where it stays when the binding is decoded with a later version.
+func (binding *HttpOperation) Upgrade() error {
+	if v := binding.BindingVersion; v != "" && !IsBindingVersion("http", v) {
+		return fmt.Errorf("%w %q of http bindings", ErrUnsupportedBindingVersion, v)
+	}
+
+	binding.Type = ""
+
+	switch binding.Extensions["type"] {
+	case HttpOperationBindingRequest, HttpOperationBindingResponse:
+		delete(binding.Extensions, "type")
+	}
+
+	if binding.BindingVersion != "" {
+		binding.BindingVersion = LatestBindingVersion("http")
+	}
+
+	return nil
+}
+
 // HttpMessage is defined in AsyncAPI spec: https://github.com/asyncapi/bindings/tree/master/http#message-binding-object
 type HttpMessage struct {
 	Extensions map[string]interface{} `json:"-" yaml:"-"`
 
 	Headers        *openapi3.Schema `json:"headers,omitempty" yaml:"headers,omitempty"`
+	StatusCode     *int             `json:"statusCode,omitempty" yaml:"statusCode,omitempty"`
 	BindingVersion string           `json:"bindingVersion,omitempty" yaml:"bindingVersion,omitempty"`
 }
 
 func (value *HttpMessage) MarshalJSON() ([]byte, error) {
-	m := make(map[string]interface{}, 2+len(value.Extensions))
+	m := make(map[string]interface{}, 3+len(value.Extensions))
 	for k, v := range value.Extensions {
 		m[k] = v
 	}
@@ -146,6 +230,9 @@ func (value *HttpMessage) MarshalJSON() ([]byte, error) {
 	if value.Headers != nil {
 		m["headers"] = value.Headers
 	}
+	if value.StatusCode != nil {
+		m["statusCode"] = value.StatusCode
+	}
 	if len(value.BindingVersion) != 0 {
 		m["bindingVersion"] = value.BindingVersion
 	}
@@ -164,24 +251,64 @@ func (value *HttpMessage) UnmarshalJSON(data []byte) error {
 	delete(x.Extensions, "headers")
 	delete(x.Extensions, "bindingVersion")
 
+	// Messages have status codes since 0.3.0, before it stays in extensions.
+	if compareBindingVersions(effectiveBindingVersion("http", x.BindingVersion), httpMessageStatusCodeAdded) < 0 {
+		x.StatusCode = nil
+	} else {
+		delete(x.Extensions, "statusCode")
+	}
+
 	*value = HttpMessage(x)
 
 	return nil
 }
 
 func (value *HttpMessage) Validate(ctx context.Context) error {
+	if err := validate.Extensions(ctx, value.Extensions); err != nil {
+		return err
+	}
+
+	err := validateVersionedFields(ctx, "http", value.BindingVersion, []versionedField{
+		{name: "statusCode", since: httpMessageStatusCodeAdded, set: value.StatusCode != nil},
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := validateRange(validate.At(ctx, "statusCode"), value.StatusCode, 100, 599); err != nil {
+		return err
+	}
+
 	if v := value.Headers; v != nil {
+		ctx := validate.At(ctx, "headers")
+
 		if !v.Type.Is(openapi3.TypeObject) || len(v.Properties) == 0 {
-			return fmt.Errorf(
+			err := fmt.Errorf(
 				"the schema in the headers field MUST be of type object and have a properties key: %w",
 				validate.ErrWrongField,
 			)
+			if err := validate.Report(ctx, validate.RuleSchema, err); err != nil {
+				return err
+			}
 		}
 
-		if err := v.Validate(ctx); err != nil {
+		if err := validate.Schema(ctx, v); err != nil {
 			return err
 		}
 	}
 
 	return nil
 }
+
+// Upgrade rewrites the binding into the shape of the latest version.
+func (value *HttpMessage) Upgrade() error {
+	if v := value.BindingVersion; v != "" && !IsBindingVersion("http", v) {
+		return fmt.Errorf("%w %q of http bindings", ErrUnsupportedBindingVersion, v)
+	}
+
+	if value.BindingVersion != "" {
+		value.BindingVersion = LatestBindingVersion("http")
+	}
+
+	return nil
+}
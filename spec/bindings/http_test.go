@@ -0,0 +1,138 @@
+package bindings
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/rdmrcv/go-asyncapi2/spec/validate"
+)
+
+func TestHttpOperation_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name      string
+		data      string
+		typ       HttpOperationBindingType
+		extension interface{}
+	}{
+		{
+			name: "0.1.0",
+			data: `{"type":"request","method":"GET","bindingVersion":"0.1.0"}`,
+			typ:  HttpOperationBindingRequest,
+		},
+		{
+			name: "without version",
+			data: `{"type":"request","method":"GET"}`,
+			typ:  HttpOperationBindingRequest,
+		},
+		{
+			name:      "0.3.0",
+			data:      `{"type":"request","method":"GET","bindingVersion":"0.3.0"}`,
+			extension: "request",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var binding HttpOperation
+			if err := json.Unmarshal([]byte(tt.data), &binding); err != nil {
+				t.Fatal(err)
+			}
+
+			if binding.Type != tt.typ {
+				t.Errorf("expected type %q, got %q", tt.typ, binding.Type)
+			}
+
+			if binding.Extensions["type"] != tt.extension {
+				t.Errorf("expected type extension %v, got %v", tt.extension, binding.Extensions["type"])
+			}
+		})
+	}
+}
+
+func TestHttpBindings_Validate(t *testing.T) {
+	statusCode := 600
+
+	tests := []struct {
+		name     string
+		binding  interface{ Validate(context.Context) error }
+		pointers []string
+	}{
+		{
+			name:     "0.1.0 operation without type",
+			binding:  &HttpOperation{Method: "GET", BindingVersion: "0.1.0"},
+			pointers: []string{"/type"},
+		},
+		{
+			name:    "operation without version and type",
+			binding: &HttpOperation{Method: "GET"},
+		},
+		{
+			name:     "operation without version with type",
+			binding:  &HttpOperation{Type: HttpOperationBindingRequest},
+			pointers: []string{"/method"},
+		},
+		{
+			name:     "latest operation with type",
+			binding:  &HttpOperation{Type: HttpOperationBindingRequest, Method: "FETCH", BindingVersion: "0.3.0"},
+			pointers: []string{"/type", "/method"},
+		},
+		{
+			name:     "unsupported version",
+			binding:  &HttpOperation{Method: "GET", BindingVersion: "0.9.0"},
+			pointers: []string{"/bindingVersion"},
+		},
+		{
+			name:     "status code before 0.3.0",
+			binding:  &HttpMessage{StatusCode: &statusCode, BindingVersion: "0.2.0"},
+			pointers: []string{"/statusCode", "/statusCode"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validate.Collect(context.Background(), tt.binding.Validate)
+
+			var errs validate.Errors
+			if err != nil && !errors.As(err, &errs) {
+				t.Fatalf("expected validate.Errors, got %v", err)
+			}
+
+			if len(errs) != len(tt.pointers) {
+				t.Fatalf("expected errors at %v, got %v", tt.pointers, err)
+			}
+
+			for i, v := range tt.pointers {
+				if errs[i].Pointer != v {
+					t.Errorf("expected error at %s, got %s", v, errs[i].Pointer)
+				}
+			}
+		})
+	}
+}
+
+func TestHttpOperation_Upgrade(t *testing.T) {
+	var binding HttpOperation
+	data := `{"type":"request","method":"POST","bindingVersion":"0.1.0"}`
+	if err := json.Unmarshal([]byte(data), &binding); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := binding.Upgrade(); err != nil {
+		t.Fatal(err)
+	}
+
+	if binding.Type != "" || binding.Method != "POST" || binding.BindingVersion != LatestBindingVersion("http") {
+		t.Errorf("unexpected upgraded binding %+v", binding)
+	}
+
+	if err := binding.Validate(context.Background()); err != nil {
+		t.Errorf("expected upgraded binding to be valid, got %v", err)
+	}
+
+	unsupported := &HttpOperation{BindingVersion: "0.9.0"}
+	if err := unsupported.Upgrade(); !errors.Is(err, ErrUnsupportedBindingVersion) {
+		t.Errorf("expected %v, got %v", ErrUnsupportedBindingVersion, err)
+	}
+}
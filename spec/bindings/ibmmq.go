@@ -0,0 +1,416 @@
+package bindings
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+
+	"github.com/rdmrcv/go-asyncapi2/spec/validate"
+)
+
+const (
+	ibmMqObjectNameMaxLength  = 48
+	ibmMqTopicStringMaxLength = 10240
+	ibmMqMaxMsgLength         = 104857600
+)
+
+type IbmMqDestinationType string
+
+const (
+	IbmMqDestinationTopic IbmMqDestinationType = "topic"
+	IbmMqDestinationQueue IbmMqDestinationType = "queue"
+)
+
+type IbmMqMessageType string
+
+const (
+	IbmMqMessageString IbmMqMessageType = "string"
+	IbmMqMessageJms    IbmMqMessageType = "jms"
+	IbmMqMessageBinary IbmMqMessageType = "binary"
+)
+
+// IbmMqServer is defined in AsyncAPI spec: https://github.com/asyncapi/bindings/tree/master/ibmmq#server-binding-object
+type IbmMqServer struct {
+	Extensions map[string]interface{} `json:"-" yaml:"-"`
+
+	GroupID              string `json:"groupId,omitempty" yaml:"groupId,omitempty"`
+	CcdtQueueManagerName string `json:"ccdtQueueManagerName,omitempty" yaml:"ccdtQueueManagerName,omitempty"`
+	CipherSpec           string `json:"cipherSpec,omitempty" yaml:"cipherSpec,omitempty"`
+	MultiEndpointServer  *bool  `json:"multiEndpointServer,omitempty" yaml:"multiEndpointServer,omitempty"`
+	HeartBeatInterval    *int   `json:"heartBeatInterval,omitempty" yaml:"heartBeatInterval,omitempty"`
+	BindingVersion       string `json:"bindingVersion,omitempty" yaml:"bindingVersion,omitempty"`
+}
+
+func (binding *IbmMqServer) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{}, 6+len(binding.Extensions))
+	for k, v := range binding.Extensions {
+		m[k] = v
+	}
+
+	if len(binding.GroupID) != 0 {
+		m["groupId"] = binding.GroupID
+	}
+	if len(binding.CcdtQueueManagerName) != 0 {
+		m["ccdtQueueManagerName"] = binding.CcdtQueueManagerName
+	}
+	if len(binding.CipherSpec) != 0 {
+		m["cipherSpec"] = binding.CipherSpec
+	}
+	if binding.MultiEndpointServer != nil {
+		m["multiEndpointServer"] = binding.MultiEndpointServer
+	}
+	if binding.HeartBeatInterval != nil {
+		m["heartBeatInterval"] = binding.HeartBeatInterval
+	}
+	if len(binding.BindingVersion) != 0 {
+		m["bindingVersion"] = binding.BindingVersion
+	}
+
+	return json.Marshal(m)
+}
+
+func (binding *IbmMqServer) UnmarshalJSON(data []byte) error {
+	type IbmMqServerBis IbmMqServer
+	var x IbmMqServerBis
+	if err := json.Unmarshal(data, &x); err != nil {
+		return err
+	}
+	_ = json.Unmarshal(data, &x.Extensions)
+
+	delete(x.Extensions, "groupId")
+	delete(x.Extensions, "ccdtQueueManagerName")
+	delete(x.Extensions, "cipherSpec")
+	delete(x.Extensions, "multiEndpointServer")
+	delete(x.Extensions, "heartBeatInterval")
+	delete(x.Extensions, "bindingVersion")
+
+	*binding = IbmMqServer(x)
+
+	return nil
+}
+
+func (binding *IbmMqServer) Validate(ctx context.Context) error {
+	if err := validate.Extensions(ctx, binding.Extensions); err != nil {
+		return err
+	}
+
+	if err := validateBindingVersion(ctx, "ibmmq", binding.BindingVersion); err != nil {
+		return err
+	}
+
+	return validateRange(validate.At(ctx, "heartBeatInterval"), binding.HeartBeatInterval, 0, 999999)
+}
+
+// IbmMqChannel is defined in AsyncAPI spec: https://github.com/asyncapi/bindings/tree/master/ibmmq#channel-binding-object
+type IbmMqChannel struct {
+	Extensions map[string]interface{} `json:"-" yaml:"-"`
+
+	DestinationType IbmMqDestinationType `json:"destinationType,omitempty" yaml:"destinationType,omitempty"`
+	Queue           *IbmMqQueue          `json:"queue,omitempty" yaml:"queue,omitempty"`
+	Topic           *IbmMqTopic          `json:"topic,omitempty" yaml:"topic,omitempty"`
+	MaxMsgLength    *int                 `json:"maxMsgLength,omitempty" yaml:"maxMsgLength,omitempty"`
+	BindingVersion  string               `json:"bindingVersion,omitempty" yaml:"bindingVersion,omitempty"`
+}
+
+func (binding *IbmMqChannel) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{}, 5+len(binding.Extensions))
+	for k, v := range binding.Extensions {
+		m[k] = v
+	}
+
+	if len(binding.DestinationType) != 0 {
+		m["destinationType"] = binding.DestinationType
+	}
+	if binding.Queue != nil {
+		m["queue"] = binding.Queue
+	}
+	if binding.Topic != nil {
+		m["topic"] = binding.Topic
+	}
+	if binding.MaxMsgLength != nil {
+		m["maxMsgLength"] = binding.MaxMsgLength
+	}
+	if len(binding.BindingVersion) != 0 {
+		m["bindingVersion"] = binding.BindingVersion
+	}
+
+	return json.Marshal(m)
+}
+
+func (binding *IbmMqChannel) UnmarshalJSON(data []byte) error {
+	type IbmMqChannelBis IbmMqChannel
+	var x IbmMqChannelBis
+	if err := json.Unmarshal(data, &x); err != nil {
+		return err
+	}
+	_ = json.Unmarshal(data, &x.Extensions)
+
+	delete(x.Extensions, "destinationType")
+	delete(x.Extensions, "queue")
+	delete(x.Extensions, "topic")
+	delete(x.Extensions, "maxMsgLength")
+	delete(x.Extensions, "bindingVersion")
+
+	*binding = IbmMqChannel(x)
+
+	return nil
+}
+
+func (binding *IbmMqChannel) Validate(ctx context.Context) error {
+	if err := validate.Extensions(ctx, binding.Extensions); err != nil {
+		return err
+	}
+
+	if err := validateBindingVersion(ctx, "ibmmq", binding.BindingVersion); err != nil {
+		return err
+	}
+
+	switch binding.DestinationType {
+	case "", IbmMqDestinationTopic:
+		if binding.Queue != nil {
+			err := fmt.Errorf("queue must not be set when destinationType is topic: %w", validate.ErrWrongField)
+			if err := validate.Report(validate.At(ctx, "queue"), validate.RuleValue, err); err != nil {
+				return err
+			}
+		}
+	case IbmMqDestinationQueue:
+		if binding.Queue == nil {
+			err := fmt.Errorf("field queue is required when destinationType is queue: %w", validate.ErrWrongField)
+			if err := validate.Report(validate.At(ctx, "queue"), validate.RuleRequired, err); err != nil {
+				return err
+			}
+		}
+
+		if binding.Topic != nil {
+			err := fmt.Errorf("topic must not be set when destinationType is queue: %w", validate.ErrWrongField)
+			if err := validate.Report(validate.At(ctx, "topic"), validate.RuleValue, err); err != nil {
+				return err
+			}
+		}
+	default:
+		err := fmt.Errorf("destinationType must be either topic or queue: %w", validate.ErrWrongField)
+		if err := validate.Report(validate.At(ctx, "destinationType"), validate.RuleValue, err); err != nil {
+			return err
+		}
+	}
+
+	if v := binding.Queue; v != nil {
+		if err := v.Validate(validate.At(ctx, "queue")); err != nil {
+			return err
+		}
+	}
+
+	if v := binding.Topic; v != nil {
+		if err := v.Validate(validate.At(ctx, "topic")); err != nil {
+			return err
+		}
+	}
+
+	return validateRange(validate.At(ctx, "maxMsgLength"), binding.MaxMsgLength, 0, ibmMqMaxMsgLength)
+}
+
+// IbmMqQueue describes the queue of the channel.
+type IbmMqQueue struct {
+	Extensions map[string]interface{} `json:"-" yaml:"-"`
+
+	ObjectName    string `json:"objectName,omitempty" yaml:"objectName,omitempty"`
+	IsPartitioned *bool  `json:"isPartitioned,omitempty" yaml:"isPartitioned,omitempty"`
+	Exclusive     *bool  `json:"exclusive,omitempty" yaml:"exclusive,omitempty"`
+}
+
+func (value *IbmMqQueue) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{}, 3+len(value.Extensions))
+	for k, v := range value.Extensions {
+		m[k] = v
+	}
+
+	if len(value.ObjectName) != 0 {
+		m["objectName"] = value.ObjectName
+	}
+	if value.IsPartitioned != nil {
+		m["isPartitioned"] = value.IsPartitioned
+	}
+	if value.Exclusive != nil {
+		m["exclusive"] = value.Exclusive
+	}
+
+	return json.Marshal(m)
+}
+
+func (value *IbmMqQueue) UnmarshalJSON(data []byte) error {
+	type IbmMqQueueBis IbmMqQueue
+	var x IbmMqQueueBis
+	if err := json.Unmarshal(data, &x); err != nil {
+		return err
+	}
+	_ = json.Unmarshal(data, &x.Extensions)
+
+	delete(x.Extensions, "objectName")
+	delete(x.Extensions, "isPartitioned")
+	delete(x.Extensions, "exclusive")
+
+	*value = IbmMqQueue(x)
+
+	return nil
+}
+
+func (value *IbmMqQueue) Validate(ctx context.Context) error {
+	if err := validate.Extensions(ctx, value.Extensions); err != nil {
+		return err
+	}
+
+	if value.ObjectName == "" {
+		err := fmt.Errorf("value of objectName must be a non-empty string: %w", validate.ErrWrongField)
+		if err := validate.Report(validate.At(ctx, "objectName"), validate.RuleRequired, err); err != nil {
+			return err
+		}
+	}
+
+	return validateMaxLength(validate.At(ctx, "objectName"), value.ObjectName, ibmMqObjectNameMaxLength)
+}
+
+// IbmMqTopic describes the topic of the channel.
+type IbmMqTopic struct {
+	Extensions map[string]interface{} `json:"-" yaml:"-"`
+
+	String           string `json:"string,omitempty" yaml:"string,omitempty"`
+	ObjectName       string `json:"objectName,omitempty" yaml:"objectName,omitempty"`
+	DurablePermitted *bool  `json:"durablePermitted,omitempty" yaml:"durablePermitted,omitempty"`
+	LastMsgRetained  *bool  `json:"lastMsgRetained,omitempty" yaml:"lastMsgRetained,omitempty"`
+}
+
+func (value *IbmMqTopic) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{}, 4+len(value.Extensions))
+	for k, v := range value.Extensions {
+		m[k] = v
+	}
+
+	if len(value.String) != 0 {
+		m["string"] = value.String
+	}
+	if len(value.ObjectName) != 0 {
+		m["objectName"] = value.ObjectName
+	}
+	if value.DurablePermitted != nil {
+		m["durablePermitted"] = value.DurablePermitted
+	}
+	if value.LastMsgRetained != nil {
+		m["lastMsgRetained"] = value.LastMsgRetained
+	}
+
+	return json.Marshal(m)
+}
+
+func (value *IbmMqTopic) UnmarshalJSON(data []byte) error {
+	type IbmMqTopicBis IbmMqTopic
+	var x IbmMqTopicBis
+	if err := json.Unmarshal(data, &x); err != nil {
+		return err
+	}
+	_ = json.Unmarshal(data, &x.Extensions)
+
+	delete(x.Extensions, "string")
+	delete(x.Extensions, "objectName")
+	delete(x.Extensions, "durablePermitted")
+	delete(x.Extensions, "lastMsgRetained")
+
+	*value = IbmMqTopic(x)
+
+	return nil
+}
+
+func (value *IbmMqTopic) Validate(ctx context.Context) error {
+	if err := validate.Extensions(ctx, value.Extensions); err != nil {
+		return err
+	}
+
+	if err := validateMaxLength(validate.At(ctx, "string"), value.String, ibmMqTopicStringMaxLength); err != nil {
+		return err
+	}
+
+	return validateMaxLength(validate.At(ctx, "objectName"), value.ObjectName, ibmMqObjectNameMaxLength)
+}
+
+// IbmMqMessage is defined in AsyncAPI spec: https://github.com/asyncapi/bindings/tree/master/ibmmq#message-binding-object
+type IbmMqMessage struct {
+	Extensions map[string]interface{} `json:"-" yaml:"-"`
+
+	Type           IbmMqMessageType `json:"type,omitempty" yaml:"type,omitempty"`
+	Headers        string           `json:"headers,omitempty" yaml:"headers,omitempty"`
+	Description    string           `json:"description,omitempty" yaml:"description,omitempty"`
+	Expiry         *int             `json:"expiry,omitempty" yaml:"expiry,omitempty"`
+	BindingVersion string           `json:"bindingVersion,omitempty" yaml:"bindingVersion,omitempty"`
+}
+
+func (value *IbmMqMessage) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{}, 5+len(value.Extensions))
+	for k, v := range value.Extensions {
+		m[k] = v
+	}
+
+	if len(value.Type) != 0 {
+		m["type"] = value.Type
+	}
+	if len(value.Headers) != 0 {
+		m["headers"] = value.Headers
+	}
+	if len(value.Description) != 0 {
+		m["description"] = value.Description
+	}
+	if value.Expiry != nil {
+		m["expiry"] = value.Expiry
+	}
+	if len(value.BindingVersion) != 0 {
+		m["bindingVersion"] = value.BindingVersion
+	}
+
+	return json.Marshal(m)
+}
+
+func (value *IbmMqMessage) UnmarshalJSON(data []byte) error {
+	type IbmMqMessageBis IbmMqMessage
+	var x IbmMqMessageBis
+	if err := json.Unmarshal(data, &x); err != nil {
+		return err
+	}
+	_ = json.Unmarshal(data, &x.Extensions)
+
+	delete(x.Extensions, "type")
+	delete(x.Extensions, "headers")
+	delete(x.Extensions, "description")
+	delete(x.Extensions, "expiry")
+	delete(x.Extensions, "bindingVersion")
+
+	*value = IbmMqMessage(x)
+
+	return nil
+}
+
+func (value *IbmMqMessage) Validate(ctx context.Context) error {
+	if err := validate.Extensions(ctx, value.Extensions); err != nil {
+		return err
+	}
+
+	if err := validateBindingVersion(ctx, "ibmmq", value.BindingVersion); err != nil {
+		return err
+	}
+
+	switch value.Type {
+	case "", IbmMqMessageString, IbmMqMessageJms:
+		if value.Headers != "" {
+			err := fmt.Errorf("headers may be set only when type is binary: %w", validate.ErrWrongField)
+			if err := validate.Report(validate.At(ctx, "headers"), validate.RuleValue, err); err != nil {
+				return err
+			}
+		}
+	case IbmMqMessageBinary:
+	default:
+		err := fmt.Errorf("type must be one of string, jms or binary: %w", validate.ErrWrongField)
+		if err := validate.Report(validate.At(ctx, "type"), validate.RuleValue, err); err != nil {
+			return err
+		}
+	}
+
+	return validateRange(validate.At(ctx, "expiry"), value.Expiry, 0, math.MaxInt32)
+}
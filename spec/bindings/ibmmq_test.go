@@ -0,0 +1,149 @@
+package bindings
+
+import (
+	"context"
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestIbmMqBindings_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		binding interface{}
+	}{
+		{
+			name:    "server",
+			data:    `{"groupId": "PRODCLSTR1", "cipherSpec": "ANY_TLS12_OR_HIGHER", "multiEndpointServer": true, "heartBeatInterval": 30, "bindingVersion": "0.1.0", "x-region": "eu"}`,
+			binding: &IbmMqServer{},
+		},
+		{
+			name:    "channel",
+			data:    `{"destinationType": "topic", "topic": {"string": "orders/created", "objectName": "ORDERS", "durablePermitted": true, "x-owner": "sales"}, "maxMsgLength": 1024, "bindingVersion": "0.1.0", "x-region": "eu"}`,
+			binding: &IbmMqChannel{},
+		},
+		{
+			name:    "channel with queue",
+			data:    `{"destinationType": "queue", "queue": {"objectName": "ORDERS", "isPartitioned": false, "exclusive": true}}`,
+			binding: &IbmMqChannel{},
+		},
+		{
+			name:    "message",
+			data:    `{"type": "binary", "headers": "Content-Type: application/json", "expiry": 0, "bindingVersion": "0.1.0", "x-region": "eu"}`,
+			binding: &IbmMqMessage{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checkRoundTrip(t, []byte(tt.data), tt.binding)
+		})
+	}
+}
+
+func TestIbmMqBindings_Validate(t *testing.T) {
+	number := func(v int) *int { return &v }
+
+	tests := []struct {
+		name     string
+		binding  interface{ Validate(context.Context) error }
+		pointers []string
+	}{
+		{
+			name:    "server",
+			binding: &IbmMqServer{HeartBeatInterval: number(999999)},
+		},
+		{
+			name:     "server heartBeatInterval out of range",
+			binding:  &IbmMqServer{HeartBeatInterval: number(1000000)},
+			pointers: []string{"/heartBeatInterval"},
+		},
+		{
+			name:     "negative server heartBeatInterval",
+			binding:  &IbmMqServer{HeartBeatInterval: number(-1)},
+			pointers: []string{"/heartBeatInterval"},
+		},
+		{
+			name:    "topic channel",
+			binding: &IbmMqChannel{Topic: &IbmMqTopic{String: "orders/created"}, MaxMsgLength: number(ibmMqMaxMsgLength)},
+		},
+		{
+			name:     "topic channel with queue",
+			binding:  &IbmMqChannel{DestinationType: IbmMqDestinationTopic, Queue: &IbmMqQueue{ObjectName: "ORDERS"}},
+			pointers: []string{"/queue"},
+		},
+		{
+			name:     "queue channel without queue",
+			binding:  &IbmMqChannel{DestinationType: IbmMqDestinationQueue},
+			pointers: []string{"/queue"},
+		},
+		{
+			name: "queue channel with topic",
+			binding: &IbmMqChannel{
+				DestinationType: IbmMqDestinationQueue,
+				Queue:           &IbmMqQueue{ObjectName: "ORDERS"},
+				Topic:           &IbmMqTopic{String: "orders/created"},
+			},
+			pointers: []string{"/topic"},
+		},
+		{
+			name:     "unknown destinationType",
+			binding:  &IbmMqChannel{DestinationType: "stream"},
+			pointers: []string{"/destinationType"},
+		},
+		{
+			name:     "queue without objectName",
+			binding:  &IbmMqChannel{DestinationType: IbmMqDestinationQueue, Queue: &IbmMqQueue{}},
+			pointers: []string{"/queue/objectName"},
+		},
+		{
+			name:     "long queue objectName",
+			binding:  &IbmMqChannel{DestinationType: IbmMqDestinationQueue, Queue: &IbmMqQueue{ObjectName: strings.Repeat("Q", 49)}},
+			pointers: []string{"/queue/objectName"},
+		},
+		{
+			name: "long topic string and objectName",
+			binding: &IbmMqChannel{Topic: &IbmMqTopic{
+				String:     strings.Repeat("t", ibmMqTopicStringMaxLength+1),
+				ObjectName: strings.Repeat("T", 49),
+			}},
+			pointers: []string{"/topic/string", "/topic/objectName"},
+		},
+		{
+			name:     "maxMsgLength out of range",
+			binding:  &IbmMqChannel{MaxMsgLength: number(ibmMqMaxMsgLength + 1)},
+			pointers: []string{"/maxMsgLength"},
+		},
+		{
+			name:     "negative maxMsgLength",
+			binding:  &IbmMqChannel{MaxMsgLength: number(-1)},
+			pointers: []string{"/maxMsgLength"},
+		},
+		{
+			name:    "binary message with headers",
+			binding: &IbmMqMessage{Type: IbmMqMessageBinary, Headers: "Content-Type: application/json", Expiry: number(math.MaxInt32)},
+		},
+		{
+			name:     "string message with headers",
+			binding:  &IbmMqMessage{Type: IbmMqMessageString, Headers: "Content-Type: application/json"},
+			pointers: []string{"/headers"},
+		},
+		{
+			name:     "unknown message type",
+			binding:  &IbmMqMessage{Type: "text"},
+			pointers: []string{"/type"},
+		},
+		{
+			name:     "negative message expiry",
+			binding:  &IbmMqMessage{Expiry: number(-1)},
+			pointers: []string{"/expiry"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checkValidate(t, tt.binding, tt.pointers)
+		})
+	}
+}
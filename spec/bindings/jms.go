@@ -0,0 +1,275 @@
+package bindings
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/getkin/kin-openapi/openapi3"
+
+	"github.com/rdmrcv/go-asyncapi2/spec/validate"
+)
+
+type JmsDestinationType string
+
+const (
+	JmsDestinationQueue     JmsDestinationType = "queue"
+	JmsDestinationFifoQueue JmsDestinationType = "fifo-queue"
+)
+
+// JmsServer is defined in AsyncAPI spec: https://github.com/asyncapi/bindings/tree/master/jms#server-binding-object
+type JmsServer struct {
+	Extensions map[string]interface{} `json:"-" yaml:"-"`
+
+	JmsConnectionFactory string         `json:"jmsConnectionFactory,omitempty" yaml:"jmsConnectionFactory,omitempty"`
+	Properties           []*JmsProperty `json:"properties,omitempty" yaml:"properties,omitempty"`
+	ClientID             string         `json:"clientID,omitempty" yaml:"clientID,omitempty"`
+	BindingVersion       string         `json:"bindingVersion,omitempty" yaml:"bindingVersion,omitempty"`
+}
+
+func (binding *JmsServer) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{}, 4+len(binding.Extensions))
+	for k, v := range binding.Extensions {
+		m[k] = v
+	}
+
+	if len(binding.JmsConnectionFactory) != 0 {
+		m["jmsConnectionFactory"] = binding.JmsConnectionFactory
+	}
+	if len(binding.Properties) != 0 {
+		m["properties"] = binding.Properties
+	}
+	if len(binding.ClientID) != 0 {
+		m["clientID"] = binding.ClientID
+	}
+	if len(binding.BindingVersion) != 0 {
+		m["bindingVersion"] = binding.BindingVersion
+	}
+
+	return json.Marshal(m)
+}
+
+func (binding *JmsServer) UnmarshalJSON(data []byte) error {
+	type JmsServerBis JmsServer
+	var x JmsServerBis
+	if err := json.Unmarshal(data, &x); err != nil {
+		return err
+	}
+	_ = json.Unmarshal(data, &x.Extensions)
+
+	delete(x.Extensions, "jmsConnectionFactory")
+	delete(x.Extensions, "properties")
+	delete(x.Extensions, "clientID")
+	delete(x.Extensions, "bindingVersion")
+
+	*binding = JmsServer(x)
+
+	return nil
+}
+
+func (binding *JmsServer) Validate(ctx context.Context) error {
+	if err := validate.Extensions(ctx, binding.Extensions); err != nil {
+		return err
+	}
+
+	if err := validateBindingVersion(ctx, "jms", binding.BindingVersion); err != nil {
+		return err
+	}
+
+	if binding.JmsConnectionFactory == "" {
+		err := fmt.Errorf("value of jmsConnectionFactory must be a non-empty string: %w", validate.ErrWrongField)
+		if err := validate.Report(validate.At(ctx, "jmsConnectionFactory"), validate.RuleRequired, err); err != nil {
+			return err
+		}
+	}
+
+	for i, v := range binding.Properties {
+		if v == nil || v.Name == "" {
+			err := fmt.Errorf("value of name must be a non-empty string: %w", validate.ErrWrongField)
+			if err := validate.Report(validate.At(ctx, "properties", strconv.Itoa(i), "name"), validate.RuleRequired, err); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// JmsProperty is a property of the JMS connection factory.
+type JmsProperty struct {
+	Name  string      `json:"name" yaml:"name"`
+	Value interface{} `json:"value" yaml:"value"`
+}
+
+// JmsChannel is defined in AsyncAPI spec: https://github.com/asyncapi/bindings/tree/master/jms#channel-binding-object
+type JmsChannel struct {
+	Extensions map[string]interface{} `json:"-" yaml:"-"`
+
+	Destination     string             `json:"destination,omitempty" yaml:"destination,omitempty"`
+	DestinationType JmsDestinationType `json:"destinationType,omitempty" yaml:"destinationType,omitempty"`
+	BindingVersion  string             `json:"bindingVersion,omitempty" yaml:"bindingVersion,omitempty"`
+}
+
+func (binding *JmsChannel) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{}, 3+len(binding.Extensions))
+	for k, v := range binding.Extensions {
+		m[k] = v
+	}
+
+	if len(binding.Destination) != 0 {
+		m["destination"] = binding.Destination
+	}
+	if len(binding.DestinationType) != 0 {
+		m["destinationType"] = binding.DestinationType
+	}
+	if len(binding.BindingVersion) != 0 {
+		m["bindingVersion"] = binding.BindingVersion
+	}
+
+	return json.Marshal(m)
+}
+
+func (binding *JmsChannel) UnmarshalJSON(data []byte) error {
+	type JmsChannelBis JmsChannel
+	var x JmsChannelBis
+	if err := json.Unmarshal(data, &x); err != nil {
+		return err
+	}
+	_ = json.Unmarshal(data, &x.Extensions)
+
+	delete(x.Extensions, "destination")
+	delete(x.Extensions, "destinationType")
+	delete(x.Extensions, "bindingVersion")
+
+	*binding = JmsChannel(x)
+
+	return nil
+}
+
+func (binding *JmsChannel) Validate(ctx context.Context) error {
+	if err := validate.Extensions(ctx, binding.Extensions); err != nil {
+		return err
+	}
+
+	if err := validateBindingVersion(ctx, "jms", binding.BindingVersion); err != nil {
+		return err
+	}
+
+	switch binding.DestinationType {
+	case "", JmsDestinationQueue, JmsDestinationFifoQueue:
+	default:
+		err := fmt.Errorf("destinationType must be either queue or fifo-queue: %w", validate.ErrWrongField)
+		if err := validate.Report(validate.At(ctx, "destinationType"), validate.RuleValue, err); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// JmsOperation is defined in AsyncAPI spec: https://github.com/asyncapi/bindings/tree/master/jms#operation-binding-object
+type JmsOperation struct {
+	Extensions map[string]interface{} `json:"-" yaml:"-"`
+
+	BindingVersion string `json:"bindingVersion,omitempty" yaml:"bindingVersion,omitempty"`
+}
+
+func (binding *JmsOperation) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{}, 1+len(binding.Extensions))
+	for k, v := range binding.Extensions {
+		m[k] = v
+	}
+
+	if len(binding.BindingVersion) != 0 {
+		m["bindingVersion"] = binding.BindingVersion
+	}
+
+	return json.Marshal(m)
+}
+
+func (binding *JmsOperation) UnmarshalJSON(data []byte) error {
+	type JmsOperationBis JmsOperation
+	var x JmsOperationBis
+	if err := json.Unmarshal(data, &x); err != nil {
+		return err
+	}
+	_ = json.Unmarshal(data, &x.Extensions)
+
+	delete(x.Extensions, "bindingVersion")
+
+	*binding = JmsOperation(x)
+
+	return nil
+}
+
+func (binding *JmsOperation) Validate(ctx context.Context) error {
+	if err := validate.Extensions(ctx, binding.Extensions); err != nil {
+		return err
+	}
+
+	if err := validateBindingVersion(ctx, "jms", binding.BindingVersion); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// JmsMessage is defined in AsyncAPI spec: https://github.com/asyncapi/bindings/tree/master/jms#message-binding-object
+type JmsMessage struct {
+	Extensions map[string]interface{} `json:"-" yaml:"-"`
+
+	// Headers describes JMS headers like JMSMessageID and JMSType.
+	Headers        *openapi3.Schema `json:"headers,omitempty" yaml:"headers,omitempty"`
+	BindingVersion string           `json:"bindingVersion,omitempty" yaml:"bindingVersion,omitempty"`
+}
+
+func (value *JmsMessage) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{}, 2+len(value.Extensions))
+	for k, v := range value.Extensions {
+		m[k] = v
+	}
+
+	if value.Headers != nil {
+		m["headers"] = value.Headers
+	}
+	if len(value.BindingVersion) != 0 {
+		m["bindingVersion"] = value.BindingVersion
+	}
+
+	return json.Marshal(m)
+}
+
+func (value *JmsMessage) UnmarshalJSON(data []byte) error {
+	type JmsMessageBis JmsMessage
+	var x JmsMessageBis
+	if err := json.Unmarshal(data, &x); err != nil {
+		return err
+	}
+	_ = json.Unmarshal(data, &x.Extensions)
+
+	delete(x.Extensions, "headers")
+	delete(x.Extensions, "bindingVersion")
+
+	*value = JmsMessage(x)
+
+	return nil
+}
+
+func (value *JmsMessage) Validate(ctx context.Context) error {
+	if err := validate.Extensions(ctx, value.Extensions); err != nil {
+		return err
+	}
+
+	if err := validateBindingVersion(ctx, "jms", value.BindingVersion); err != nil {
+		return err
+	}
+
+	if v := value.Headers; v != nil {
+		if err := validate.Schema(validate.At(ctx, "headers"), v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,71 @@
+package bindings
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/rdmrcv/go-asyncapi2/spec/validate"
+)
+
+func TestJmsBindings_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		binding  interface{ Validate(context.Context) error }
+		pointers []string
+	}{
+		{
+			name: "valid server",
+			binding: &JmsServer{
+				JmsConnectionFactory: "org.apache.activemq.ActiveMQConnectionFactory",
+				Properties:           []*JmsProperty{{Name: "disableTimeStampsByDefault", Value: false}},
+			},
+		},
+		{
+			name:     "invalid server",
+			binding:  &JmsServer{Properties: []*JmsProperty{{Value: false}}},
+			pointers: []string{"/jmsConnectionFactory", "/properties/0/name"},
+		},
+		{
+			name:     "destination type",
+			binding:  &JmsChannel{DestinationType: "topic"},
+			pointers: []string{"/destinationType"},
+		},
+		{
+			name:     "nats queue",
+			binding:  &NatsOperation{Queue: strings.Repeat("q", 256)},
+			pointers: []string{"/queue"},
+		},
+		{
+			name:     "nats channel binding version",
+			binding:  &NatsChannel{BindingVersion: "0.2.0"},
+			pointers: []string{"/bindingVersion"},
+		},
+		{
+			name:    "jms operation",
+			binding: &JmsOperation{BindingVersion: "0.0.1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validate.Collect(context.Background(), tt.binding.Validate)
+
+			var errs validate.Errors
+			if err != nil && !errors.As(err, &errs) {
+				t.Fatalf("expected validate.Errors, got %v", err)
+			}
+
+			if len(errs) != len(tt.pointers) {
+				t.Fatalf("expected errors at %v, got %v", tt.pointers, err)
+			}
+
+			for i, v := range tt.pointers {
+				if errs[i].Pointer != v {
+					t.Errorf("expected error at %s, got %s", v, errs[i].Pointer)
+				}
+			}
+		})
+	}
+}
@@ -3,23 +3,268 @@ package bindings
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"strconv"
 
 	"github.com/getkin/kin-openapi/openapi3"
+
+	"github.com/rdmrcv/go-asyncapi2/spec/validate"
+)
+
+const (
+	KafkaCleanupPolicyDelete  = "delete"
+	KafkaCleanupPolicyCompact = "compact"
+)
+
+const (
+	KafkaSchemaIDLocationHeader  = "header"
+	KafkaSchemaIDLocationPayload = "payload"
 )
 
 // KafkaServer is defined in AsyncAPI spec: https://github.com/asyncapi/bindings/tree/master/kafka#server-binding-object
 type KafkaServer struct {
+	Extensions map[string]interface{} `json:"-" yaml:"-"`
+
+	SchemaRegistryURL    string `json:"schemaRegistryUrl,omitempty" yaml:"schemaRegistryUrl,omitempty"`
+	SchemaRegistryVendor string `json:"schemaRegistryVendor,omitempty" yaml:"schemaRegistryVendor,omitempty"`
+	BindingVersion       string `json:"bindingVersion,omitempty" yaml:"bindingVersion,omitempty"`
 }
 
-func (binding *KafkaServer) Validate(ctx context.Context) error {
+func (binding *KafkaServer) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{}, 3+len(binding.Extensions))
+	for k, v := range binding.Extensions {
+		m[k] = v
+	}
+
+	if len(binding.SchemaRegistryURL) != 0 {
+		m["schemaRegistryUrl"] = binding.SchemaRegistryURL
+	}
+	if len(binding.SchemaRegistryVendor) != 0 {
+		m["schemaRegistryVendor"] = binding.SchemaRegistryVendor
+	}
+	if len(binding.BindingVersion) != 0 {
+		m["bindingVersion"] = binding.BindingVersion
+	}
+
+	return json.Marshal(m)
+}
+
+func (binding *KafkaServer) UnmarshalJSON(data []byte) error {
+	type KafkaServerBis KafkaServer
+	var x KafkaServerBis
+	if err := json.Unmarshal(data, &x); err != nil {
+		return err
+	}
+	_ = json.Unmarshal(data, &x.Extensions)
+
+	delete(x.Extensions, "schemaRegistryUrl")
+	delete(x.Extensions, "schemaRegistryVendor")
+	delete(x.Extensions, "bindingVersion")
+
+	*binding = KafkaServer(x)
+
 	return nil
 }
 
+func (binding *KafkaServer) Validate(ctx context.Context) error {
+	if err := validate.Extensions(ctx, binding.Extensions); err != nil {
+		return err
+	}
+
+	return validateVersionedFields(ctx, "kafka", binding.BindingVersion, []versionedField{
+		{name: "schemaRegistryUrl", since: "0.3.0", set: len(binding.SchemaRegistryURL) != 0},
+		{name: "schemaRegistryVendor", since: "0.3.0", set: len(binding.SchemaRegistryVendor) != 0},
+	})
+}
+
 // KafkaChannel is defined in AsyncAPI spec: https://github.com/asyncapi/bindings/tree/master/kafka#channel-binding-object
 type KafkaChannel struct {
+	Extensions map[string]interface{} `json:"-" yaml:"-"`
+
+	Topic              string                   `json:"topic,omitempty" yaml:"topic,omitempty"`
+	Partitions         *int                     `json:"partitions,omitempty" yaml:"partitions,omitempty"`
+	Replicas           *int                     `json:"replicas,omitempty" yaml:"replicas,omitempty"`
+	TopicConfiguration *KafkaTopicConfiguration `json:"topicConfiguration,omitempty" yaml:"topicConfiguration,omitempty"`
+	BindingVersion     string                   `json:"bindingVersion,omitempty" yaml:"bindingVersion,omitempty"`
+}
+
+func (binding *KafkaChannel) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{}, 5+len(binding.Extensions))
+	for k, v := range binding.Extensions {
+		m[k] = v
+	}
+
+	if len(binding.Topic) != 0 {
+		m["topic"] = binding.Topic
+	}
+	if binding.Partitions != nil {
+		m["partitions"] = binding.Partitions
+	}
+	if binding.Replicas != nil {
+		m["replicas"] = binding.Replicas
+	}
+	if binding.TopicConfiguration != nil {
+		m["topicConfiguration"] = binding.TopicConfiguration
+	}
+	if len(binding.BindingVersion) != 0 {
+		m["bindingVersion"] = binding.BindingVersion
+	}
+
+	return json.Marshal(m)
+}
+
+func (binding *KafkaChannel) UnmarshalJSON(data []byte) error {
+	type KafkaChannelBis KafkaChannel
+	var x KafkaChannelBis
+	if err := json.Unmarshal(data, &x); err != nil {
+		return err
+	}
+	_ = json.Unmarshal(data, &x.Extensions)
+
+	delete(x.Extensions, "topic")
+	delete(x.Extensions, "partitions")
+	delete(x.Extensions, "replicas")
+	delete(x.Extensions, "topicConfiguration")
+	delete(x.Extensions, "bindingVersion")
+
+	*binding = KafkaChannel(x)
+
+	return nil
+}
+
+func (binding *KafkaChannel) Validate(ctx context.Context) error {
+	if err := validate.Extensions(ctx, binding.Extensions); err != nil {
+		return err
+	}
+
+	err := validateVersionedFields(ctx, "kafka", binding.BindingVersion, []versionedField{
+		{name: "topic", since: "0.3.0", set: len(binding.Topic) != 0},
+		{name: "partitions", since: "0.3.0", set: binding.Partitions != nil},
+		{name: "replicas", since: "0.3.0", set: binding.Replicas != nil},
+		{name: "topicConfiguration", since: "0.4.0", set: binding.TopicConfiguration != nil},
+	})
+	if err != nil {
+		return err
+	}
+
+	if v := binding.Partitions; v != nil && *v <= 0 {
+		err := fmt.Errorf("partitions must be greater than 0: %w", validate.ErrWrongField)
+		if err := validate.Report(validate.At(ctx, "partitions"), validate.RuleValue, err); err != nil {
+			return err
+		}
+	}
+
+	if v := binding.Replicas; v != nil && *v <= 0 {
+		err := fmt.Errorf("replicas must be greater than 0: %w", validate.ErrWrongField)
+		if err := validate.Report(validate.At(ctx, "replicas"), validate.RuleValue, err); err != nil {
+			return err
+		}
+	}
+
+	if v := binding.TopicConfiguration; v != nil {
+		if err := v.Validate(validate.At(ctx, "topicConfiguration")); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
-func (*KafkaChannel) Validate(context.Context) error {
+// KafkaTopicConfiguration describes configuration of the topic of the channel.
+type KafkaTopicConfiguration struct {
+	Extensions map[string]interface{} `json:"-" yaml:"-"`
+
+	CleanupPolicy     []string `json:"cleanup.policy,omitempty" yaml:"cleanup.policy,omitempty"`
+	RetentionMs       *int64   `json:"retention.ms,omitempty" yaml:"retention.ms,omitempty"`
+	RetentionBytes    *int64   `json:"retention.bytes,omitempty" yaml:"retention.bytes,omitempty"`
+	DeleteRetentionMs *int64   `json:"delete.retention.ms,omitempty" yaml:"delete.retention.ms,omitempty"`
+	MaxMessageBytes   *int32   `json:"max.message.bytes,omitempty" yaml:"max.message.bytes,omitempty"`
+}
+
+func (value *KafkaTopicConfiguration) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{}, 5+len(value.Extensions))
+	for k, v := range value.Extensions {
+		m[k] = v
+	}
+
+	if len(value.CleanupPolicy) != 0 {
+		m["cleanup.policy"] = value.CleanupPolicy
+	}
+	if value.RetentionMs != nil {
+		m["retention.ms"] = value.RetentionMs
+	}
+	if value.RetentionBytes != nil {
+		m["retention.bytes"] = value.RetentionBytes
+	}
+	if value.DeleteRetentionMs != nil {
+		m["delete.retention.ms"] = value.DeleteRetentionMs
+	}
+	if value.MaxMessageBytes != nil {
+		m["max.message.bytes"] = value.MaxMessageBytes
+	}
+
+	return json.Marshal(m)
+}
+
+func (value *KafkaTopicConfiguration) UnmarshalJSON(data []byte) error {
+	type KafkaTopicConfigurationBis KafkaTopicConfiguration
+	var x KafkaTopicConfigurationBis
+	if err := json.Unmarshal(data, &x); err != nil {
+		return err
+	}
+	_ = json.Unmarshal(data, &x.Extensions)
+
+	delete(x.Extensions, "cleanup.policy")
+	delete(x.Extensions, "retention.ms")
+	delete(x.Extensions, "retention.bytes")
+	delete(x.Extensions, "delete.retention.ms")
+	delete(x.Extensions, "max.message.bytes")
+
+	*value = KafkaTopicConfiguration(x)
+
+	return nil
+}
+
+func (value *KafkaTopicConfiguration) Validate(ctx context.Context) error {
+	if err := validate.Extensions(ctx, value.Extensions); err != nil {
+		return err
+	}
+
+	for i, v := range value.CleanupPolicy {
+		if v != KafkaCleanupPolicyDelete && v != KafkaCleanupPolicyCompact {
+			err := fmt.Errorf("cleanup policy %q must be either delete or compact: %w", v, validate.ErrWrongField)
+			if err := validate.Report(validate.At(ctx, "cleanup.policy", strconv.Itoa(i)), validate.RuleValue, err); err != nil {
+				return err
+			}
+		}
+	}
+
+	limits := []struct {
+		field string
+		value *int64
+		min   int64
+	}{
+		{"retention.ms", value.RetentionMs, -1},
+		{"retention.bytes", value.RetentionBytes, -1},
+		{"delete.retention.ms", value.DeleteRetentionMs, 0},
+	}
+
+	for _, v := range limits {
+		if v.value != nil && *v.value < v.min {
+			err := fmt.Errorf("%s must be greater than or equal to %d: %w", v.field, v.min, validate.ErrWrongField)
+			if err := validate.Report(validate.At(ctx, v.field), validate.RuleValue, err); err != nil {
+				return err
+			}
+		}
+	}
+
+	if v := value.MaxMessageBytes; v != nil && *v < 1 {
+		err := fmt.Errorf("max.message.bytes must be greater than 0: %w", validate.ErrWrongField)
+		if err := validate.Report(validate.At(ctx, "max.message.bytes"), validate.RuleValue, err); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -27,9 +272,9 @@ func (*KafkaChannel) Validate(context.Context) error {
 type KafkaOperation struct {
 	Extensions map[string]interface{} `json:"-" yaml:"-"`
 
-	GroupID        *openapi3.Schema `json:"groupId" yaml:"groupId"`
-	ClientID       *openapi3.Schema `json:"clientId" yaml:"clientId"`
-	BindingVersion string           `json:"bindingVersion" yaml:"bindingVersion"`
+	GroupID        *openapi3.Schema `json:"groupId,omitempty" yaml:"groupId,omitempty"`
+	ClientID       *openapi3.Schema `json:"clientId,omitempty" yaml:"clientId,omitempty"`
+	BindingVersion string           `json:"bindingVersion,omitempty" yaml:"bindingVersion,omitempty"`
 }
 
 func (binding *KafkaOperation) MarshalJSON() ([]byte, error) {
@@ -69,14 +314,22 @@ func (binding *KafkaOperation) UnmarshalJSON(data []byte) error {
 }
 
 func (binding *KafkaOperation) Validate(ctx context.Context) error {
+	if err := validate.Extensions(ctx, binding.Extensions); err != nil {
+		return err
+	}
+
+	if err := validateBindingVersion(ctx, "kafka", binding.BindingVersion); err != nil {
+		return err
+	}
+
 	if v := binding.GroupID; v != nil {
-		if err := v.Validate(ctx); err != nil {
+		if err := validate.Schema(validate.At(ctx, "groupId"), v); err != nil {
 			return err
 		}
 	}
 
 	if v := binding.ClientID; v != nil {
-		if err := v.Validate(ctx); err != nil {
+		if err := validate.Schema(validate.At(ctx, "clientId"), v); err != nil {
 			return err
 		}
 	}
@@ -88,12 +341,15 @@ func (binding *KafkaOperation) Validate(ctx context.Context) error {
 type KafkaMessage struct {
 	Extensions map[string]interface{} `json:"-" yaml:"-"`
 
-	Key            *openapi3.Schema `json:"key" yaml:"key"`
-	BindingVersion string           `json:"bindingVersion" yaml:"bindingVersion"`
+	Key                     *openapi3.Schema `json:"key,omitempty" yaml:"key,omitempty"`
+	SchemaIDLocation        string           `json:"schemaIdLocation,omitempty" yaml:"schemaIdLocation,omitempty"`
+	SchemaIDPayloadEncoding string           `json:"schemaIdPayloadEncoding,omitempty" yaml:"schemaIdPayloadEncoding,omitempty"`
+	SchemaLookupStrategy    string           `json:"schemaLookupStrategy,omitempty" yaml:"schemaLookupStrategy,omitempty"`
+	BindingVersion          string           `json:"bindingVersion,omitempty" yaml:"bindingVersion,omitempty"`
 }
 
 func (value *KafkaMessage) MarshalJSON() ([]byte, error) {
-	m := make(map[string]interface{}, 2+len(value.Extensions))
+	m := make(map[string]interface{}, 5+len(value.Extensions))
 	for k, v := range value.Extensions {
 		m[k] = v
 	}
@@ -101,6 +357,15 @@ func (value *KafkaMessage) MarshalJSON() ([]byte, error) {
 	if value.Key != nil {
 		m["key"] = value.Key
 	}
+	if len(value.SchemaIDLocation) != 0 {
+		m["schemaIdLocation"] = value.SchemaIDLocation
+	}
+	if len(value.SchemaIDPayloadEncoding) != 0 {
+		m["schemaIdPayloadEncoding"] = value.SchemaIDPayloadEncoding
+	}
+	if len(value.SchemaLookupStrategy) != 0 {
+		m["schemaLookupStrategy"] = value.SchemaLookupStrategy
+	}
 	if len(value.BindingVersion) != 0 {
 		m["bindingVersion"] = value.BindingVersion
 	}
@@ -117,6 +382,9 @@ func (value *KafkaMessage) UnmarshalJSON(data []byte) error {
 	_ = json.Unmarshal(data, &x.Extensions)
 
 	delete(x.Extensions, "key")
+	delete(x.Extensions, "schemaIdLocation")
+	delete(x.Extensions, "schemaIdPayloadEncoding")
+	delete(x.Extensions, "schemaLookupStrategy")
 	delete(x.Extensions, "bindingVersion")
 
 	*value = KafkaMessage(x)
@@ -125,8 +393,30 @@ func (value *KafkaMessage) UnmarshalJSON(data []byte) error {
 }
 
 func (value *KafkaMessage) Validate(ctx context.Context) error {
+	if err := validate.Extensions(ctx, value.Extensions); err != nil {
+		return err
+	}
+
+	err := validateVersionedFields(ctx, "kafka", value.BindingVersion, []versionedField{
+		{name: "schemaIdLocation", since: "0.3.0", set: len(value.SchemaIDLocation) != 0},
+		{name: "schemaIdPayloadEncoding", since: "0.3.0", set: len(value.SchemaIDPayloadEncoding) != 0},
+		{name: "schemaLookupStrategy", since: "0.3.0", set: len(value.SchemaLookupStrategy) != 0},
+	})
+	if err != nil {
+		return err
+	}
+
+	switch value.SchemaIDLocation {
+	case "", KafkaSchemaIDLocationHeader, KafkaSchemaIDLocationPayload:
+	default:
+		err := fmt.Errorf("schemaIdLocation must be either header or payload: %w", validate.ErrWrongField)
+		if err := validate.Report(validate.At(ctx, "schemaIdLocation"), validate.RuleValue, err); err != nil {
+			return err
+		}
+	}
+
 	if v := value.Key; v != nil {
-		if err := v.Validate(ctx); err != nil {
+		if err := validate.Schema(validate.At(ctx, "key"), v); err != nil {
 			return err
 		}
 	}
@@ -0,0 +1,88 @@
+package bindings
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/rdmrcv/go-asyncapi2/spec/validate"
+)
+
+func TestKafkaOperation_MarshalJSON(t *testing.T) {
+	for _, v := range []interface{}{KafkaOperation{BindingVersion: "0.4.0"}, &KafkaOperation{BindingVersion: "0.4.0"}} {
+		data, err := json.Marshal(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if string(data) != `{"bindingVersion":"0.4.0"}` {
+			t.Errorf("unexpected %s", data)
+		}
+	}
+}
+
+func TestKafkaBindings_Validate(t *testing.T) {
+	partitions := func(v int) *int { return &v }
+
+	tests := []struct {
+		name     string
+		binding  interface{ Validate(context.Context) error }
+		pointers []string
+	}{
+		{
+			name: "valid channel",
+			binding: &KafkaChannel{
+				Topic:              "my-topic",
+				Partitions:         partitions(20),
+				Replicas:           partitions(3),
+				TopicConfiguration: &KafkaTopicConfiguration{CleanupPolicy: []string{"delete", "compact"}},
+				BindingVersion:     "0.4.0",
+			},
+		},
+		{
+			name: "invalid channel",
+			binding: &KafkaChannel{
+				Partitions:         partitions(0),
+				TopicConfiguration: &KafkaTopicConfiguration{CleanupPolicy: []string{"delete", "archive"}},
+			},
+			pointers: []string{"/partitions", "/topicConfiguration/cleanup.policy/1"},
+		},
+		{
+			name:     "field of later version",
+			binding:  &KafkaChannel{Topic: "my-topic", BindingVersion: "0.1.0"},
+			pointers: []string{"/topic"},
+		},
+		{
+			name:     "unknown version",
+			binding:  &KafkaServer{BindingVersion: "0.2.0"},
+			pointers: []string{"/bindingVersion"},
+		},
+		{
+			name:     "schema id location",
+			binding:  &KafkaMessage{SchemaIDLocation: "key"},
+			pointers: []string{"/schemaIdLocation"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validate.Collect(context.Background(), tt.binding.Validate)
+
+			var errs validate.Errors
+			if err != nil && !errors.As(err, &errs) {
+				t.Fatalf("expected validate.Errors, got %v", err)
+			}
+
+			if len(errs) != len(tt.pointers) {
+				t.Fatalf("expected errors at %v, got %v", tt.pointers, err)
+			}
+
+			for i, v := range tt.pointers {
+				if errs[i].Pointer != v {
+					t.Errorf("expected error at %s, got %s", v, errs[i].Pointer)
+				}
+			}
+		})
+	}
+}
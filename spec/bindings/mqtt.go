@@ -0,0 +1,652 @@
+package bindings
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/getkin/kin-openapi/openapi3"
+
+	"github.com/rdmrcv/go-asyncapi2/spec/validate"
+)
+
+const (
+	MqttQoSAtMostOnce  = 0
+	MqttQoSAtLeastOnce = 1
+	MqttQoSExactlyOnce = 2
+)
+
+const (
+	MqttPayloadFormatUnspecified = 0
+	MqttPayloadFormatUTF8        = 1
+)
+
+// mqtt5FieldsAdded is the binding version MQTT bindings have fields of MQTT 5 since.
+const mqtt5FieldsAdded = "0.2.0"
+
+// MqttValue holds either the value of a field or the schema of its values,
+// as fields of MQTT 5 take both.
+type MqttValue[V int | string] struct {
+	Value  *V
+	Schema *openapi3.Schema
+}
+
+func (value *MqttValue[V]) MarshalJSON() ([]byte, error) {
+	if value.Schema != nil {
+		return json.Marshal(value.Schema)
+	}
+
+	return json.Marshal(value.Value)
+}
+
+func (value *MqttValue[V]) UnmarshalJSON(data []byte) error {
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("{")) {
+		value.Value, value.Schema = nil, &openapi3.Schema{}
+
+		return json.Unmarshal(data, value.Schema)
+	}
+
+	value.Value, value.Schema = new(V), nil
+
+	return json.Unmarshal(data, value.Value)
+}
+
+func (value *MqttValue[V]) Validate(ctx context.Context) error {
+	if value.Schema != nil {
+		return validate.Schema(ctx, value.Schema)
+	}
+
+	return nil
+}
+
+// MqttServer is defined in AsyncAPI spec: https://github.com/asyncapi/bindings/tree/master/mqtt#server-binding-object
+type MqttServer struct {
+	Extensions map[string]interface{} `json:"-" yaml:"-"`
+
+	ClientID              string          `json:"clientId,omitempty" yaml:"clientId,omitempty"`
+	CleanSession          *bool           `json:"cleanSession,omitempty" yaml:"cleanSession,omitempty"`
+	LastWill              *MqttLastWill   `json:"lastWill,omitempty" yaml:"lastWill,omitempty"`
+	KeepAlive             *int            `json:"keepAlive,omitempty" yaml:"keepAlive,omitempty"`
+	SessionExpiryInterval *MqttValue[int] `json:"sessionExpiryInterval,omitempty" yaml:"sessionExpiryInterval,omitempty"`
+	MaximumPacketSize     *MqttValue[int] `json:"maximumPacketSize,omitempty" yaml:"maximumPacketSize,omitempty"`
+	BindingVersion        string          `json:"bindingVersion,omitempty" yaml:"bindingVersion,omitempty"`
+}
+
+func (binding *MqttServer) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{}, 7+len(binding.Extensions))
+	for k, v := range binding.Extensions {
+		m[k] = v
+	}
+
+	if len(binding.ClientID) != 0 {
+		m["clientId"] = binding.ClientID
+	}
+	if binding.CleanSession != nil {
+		m["cleanSession"] = binding.CleanSession
+	}
+	if binding.LastWill != nil {
+		m["lastWill"] = binding.LastWill
+	}
+	if binding.KeepAlive != nil {
+		m["keepAlive"] = binding.KeepAlive
+	}
+	if binding.SessionExpiryInterval != nil {
+		m["sessionExpiryInterval"] = binding.SessionExpiryInterval
+	}
+	if binding.MaximumPacketSize != nil {
+		m["maximumPacketSize"] = binding.MaximumPacketSize
+	}
+	if len(binding.BindingVersion) != 0 {
+		m["bindingVersion"] = binding.BindingVersion
+	}
+
+	return json.Marshal(m)
+}
+
+func (binding *MqttServer) UnmarshalJSON(data []byte) error {
+	type MqttServerBis MqttServer
+	var x MqttServerBis
+	if err := json.Unmarshal(data, &x); err != nil {
+		return err
+	}
+	_ = json.Unmarshal(data, &x.Extensions)
+
+	delete(x.Extensions, "clientId")
+	delete(x.Extensions, "cleanSession")
+	delete(x.Extensions, "lastWill")
+	delete(x.Extensions, "keepAlive")
+	delete(x.Extensions, "sessionExpiryInterval")
+	delete(x.Extensions, "maximumPacketSize")
+	delete(x.Extensions, "bindingVersion")
+
+	*binding = MqttServer(x)
+
+	return nil
+}
+
+func (binding *MqttServer) Validate(ctx context.Context) error {
+	if err := validate.Extensions(ctx, binding.Extensions); err != nil {
+		return err
+	}
+
+	err := validateVersionedFields(ctx, "mqtt", binding.BindingVersion, []versionedField{
+		{name: "sessionExpiryInterval", since: mqtt5FieldsAdded, set: binding.SessionExpiryInterval != nil},
+		{name: "maximumPacketSize", since: mqtt5FieldsAdded, set: binding.MaximumPacketSize != nil},
+	})
+	if err != nil {
+		return err
+	}
+
+	if v := binding.LastWill; v != nil {
+		if err := v.Validate(validate.At(ctx, "lastWill")); err != nil {
+			return err
+		}
+	}
+
+	if v := binding.KeepAlive; v != nil && *v < 0 {
+		err := fmt.Errorf("keepAlive must be greater than or equal to 0: %w", validate.ErrWrongField)
+		if err := validate.Report(validate.At(ctx, "keepAlive"), validate.RuleValue, err); err != nil {
+			return err
+		}
+	}
+
+	if err := validateMqttInteger(validate.At(ctx, "sessionExpiryInterval"), binding.SessionExpiryInterval, 0); err != nil {
+		return err
+	}
+
+	return validateMqttInteger(validate.At(ctx, "maximumPacketSize"), binding.MaximumPacketSize, 1)
+}
+
+// MqttLastWill describes the last will and testament of the client.
+type MqttLastWill struct {
+	Extensions map[string]interface{} `json:"-" yaml:"-"`
+
+	Topic   string `json:"topic,omitempty" yaml:"topic,omitempty"`
+	QoS     *int   `json:"qos,omitempty" yaml:"qos,omitempty"`
+	Message string `json:"message,omitempty" yaml:"message,omitempty"`
+	Retain  *bool  `json:"retain,omitempty" yaml:"retain,omitempty"`
+}
+
+func (value *MqttLastWill) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{}, 4+len(value.Extensions))
+	for k, v := range value.Extensions {
+		m[k] = v
+	}
+
+	if len(value.Topic) != 0 {
+		m["topic"] = value.Topic
+	}
+	if value.QoS != nil {
+		m["qos"] = value.QoS
+	}
+	if len(value.Message) != 0 {
+		m["message"] = value.Message
+	}
+	if value.Retain != nil {
+		m["retain"] = value.Retain
+	}
+
+	return json.Marshal(m)
+}
+
+func (value *MqttLastWill) UnmarshalJSON(data []byte) error {
+	type MqttLastWillBis MqttLastWill
+	var x MqttLastWillBis
+	if err := json.Unmarshal(data, &x); err != nil {
+		return err
+	}
+	_ = json.Unmarshal(data, &x.Extensions)
+
+	delete(x.Extensions, "topic")
+	delete(x.Extensions, "qos")
+	delete(x.Extensions, "message")
+	delete(x.Extensions, "retain")
+
+	*value = MqttLastWill(x)
+
+	return nil
+}
+
+func (value *MqttLastWill) Validate(ctx context.Context) error {
+	if err := validate.Extensions(ctx, value.Extensions); err != nil {
+		return err
+	}
+
+	return validateMqttQoS(validate.At(ctx, "qos"), value.QoS)
+}
+
+// MqttChannel is defined in AsyncAPI spec: https://github.com/asyncapi/bindings/tree/master/mqtt#channel-binding-object
+type MqttChannel struct {
+	Extensions map[string]interface{} `json:"-" yaml:"-"`
+
+	BindingVersion string `json:"bindingVersion,omitempty" yaml:"bindingVersion,omitempty"`
+}
+
+func (binding *MqttChannel) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{}, 1+len(binding.Extensions))
+	for k, v := range binding.Extensions {
+		m[k] = v
+	}
+
+	if len(binding.BindingVersion) != 0 {
+		m["bindingVersion"] = binding.BindingVersion
+	}
+
+	return json.Marshal(m)
+}
+
+func (binding *MqttChannel) UnmarshalJSON(data []byte) error {
+	type MqttChannelBis MqttChannel
+	var x MqttChannelBis
+	if err := json.Unmarshal(data, &x); err != nil {
+		return err
+	}
+	_ = json.Unmarshal(data, &x.Extensions)
+
+	delete(x.Extensions, "bindingVersion")
+
+	*binding = MqttChannel(x)
+
+	return nil
+}
+
+func (binding *MqttChannel) Validate(ctx context.Context) error {
+	if err := validate.Extensions(ctx, binding.Extensions); err != nil {
+		return err
+	}
+
+	if err := validateBindingVersion(ctx, "mqtt", binding.BindingVersion); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MqttOperation is defined in AsyncAPI spec: https://github.com/asyncapi/bindings/tree/master/mqtt#operation-binding-object
+type MqttOperation struct {
+	Extensions map[string]interface{} `json:"-" yaml:"-"`
+
+	QoS                   *int            `json:"qos,omitempty" yaml:"qos,omitempty"`
+	Retain                *bool           `json:"retain,omitempty" yaml:"retain,omitempty"`
+	MessageExpiryInterval *MqttValue[int] `json:"messageExpiryInterval,omitempty" yaml:"messageExpiryInterval,omitempty"`
+	BindingVersion        string          `json:"bindingVersion,omitempty" yaml:"bindingVersion,omitempty"`
+}
+
+func (binding *MqttOperation) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{}, 4+len(binding.Extensions))
+	for k, v := range binding.Extensions {
+		m[k] = v
+	}
+
+	if binding.QoS != nil {
+		m["qos"] = binding.QoS
+	}
+	if binding.Retain != nil {
+		m["retain"] = binding.Retain
+	}
+	if binding.MessageExpiryInterval != nil {
+		m["messageExpiryInterval"] = binding.MessageExpiryInterval
+	}
+	if len(binding.BindingVersion) != 0 {
+		m["bindingVersion"] = binding.BindingVersion
+	}
+
+	return json.Marshal(m)
+}
+
+func (binding *MqttOperation) UnmarshalJSON(data []byte) error {
+	type MqttOperationBis MqttOperation
+	var x MqttOperationBis
+	if err := json.Unmarshal(data, &x); err != nil {
+		return err
+	}
+	_ = json.Unmarshal(data, &x.Extensions)
+
+	delete(x.Extensions, "qos")
+	delete(x.Extensions, "retain")
+	delete(x.Extensions, "messageExpiryInterval")
+	delete(x.Extensions, "bindingVersion")
+
+	*binding = MqttOperation(x)
+
+	return nil
+}
+
+func (binding *MqttOperation) Validate(ctx context.Context) error {
+	if err := validate.Extensions(ctx, binding.Extensions); err != nil {
+		return err
+	}
+
+	err := validateVersionedFields(ctx, "mqtt", binding.BindingVersion, []versionedField{
+		{name: "messageExpiryInterval", since: mqtt5FieldsAdded, set: binding.MessageExpiryInterval != nil},
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := validateMqttQoS(validate.At(ctx, "qos"), binding.QoS); err != nil {
+		return err
+	}
+
+	return validateMqttInteger(validate.At(ctx, "messageExpiryInterval"), binding.MessageExpiryInterval, 0)
+}
+
+// MqttMessage is defined in AsyncAPI spec: https://github.com/asyncapi/bindings/tree/master/mqtt#message-binding-object
+type MqttMessage struct {
+	Extensions map[string]interface{} `json:"-" yaml:"-"`
+
+	PayloadFormatIndicator *int               `json:"payloadFormatIndicator,omitempty" yaml:"payloadFormatIndicator,omitempty"`
+	CorrelationData        *openapi3.Schema   `json:"correlationData,omitempty" yaml:"correlationData,omitempty"`
+	ContentType            string             `json:"contentType,omitempty" yaml:"contentType,omitempty"`
+	ResponseTopic          *MqttValue[string] `json:"responseTopic,omitempty" yaml:"responseTopic,omitempty"`
+	BindingVersion         string             `json:"bindingVersion,omitempty" yaml:"bindingVersion,omitempty"`
+}
+
+func (value *MqttMessage) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{}, 5+len(value.Extensions))
+	for k, v := range value.Extensions {
+		m[k] = v
+	}
+
+	if value.PayloadFormatIndicator != nil {
+		m["payloadFormatIndicator"] = value.PayloadFormatIndicator
+	}
+	if value.CorrelationData != nil {
+		m["correlationData"] = value.CorrelationData
+	}
+	if len(value.ContentType) != 0 {
+		m["contentType"] = value.ContentType
+	}
+	if value.ResponseTopic != nil {
+		m["responseTopic"] = value.ResponseTopic
+	}
+	if len(value.BindingVersion) != 0 {
+		m["bindingVersion"] = value.BindingVersion
+	}
+
+	return json.Marshal(m)
+}
+
+func (value *MqttMessage) UnmarshalJSON(data []byte) error {
+	type MqttMessageBis MqttMessage
+	var x MqttMessageBis
+	if err := json.Unmarshal(data, &x); err != nil {
+		return err
+	}
+	_ = json.Unmarshal(data, &x.Extensions)
+
+	delete(x.Extensions, "payloadFormatIndicator")
+	delete(x.Extensions, "correlationData")
+	delete(x.Extensions, "contentType")
+	delete(x.Extensions, "responseTopic")
+	delete(x.Extensions, "bindingVersion")
+
+	*value = MqttMessage(x)
+
+	return nil
+}
+
+func (value *MqttMessage) Validate(ctx context.Context) error {
+	if err := validate.Extensions(ctx, value.Extensions); err != nil {
+		return err
+	}
+
+	err := validateVersionedFields(ctx, "mqtt", value.BindingVersion, []versionedField{
+		{name: "payloadFormatIndicator", since: mqtt5FieldsAdded, set: value.PayloadFormatIndicator != nil},
+		{name: "correlationData", since: mqtt5FieldsAdded, set: value.CorrelationData != nil},
+		{name: "contentType", since: mqtt5FieldsAdded, set: len(value.ContentType) != 0},
+		{name: "responseTopic", since: mqtt5FieldsAdded, set: value.ResponseTopic != nil},
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := validateRange(
+		validate.At(ctx, "payloadFormatIndicator"),
+		value.PayloadFormatIndicator,
+		MqttPayloadFormatUnspecified,
+		MqttPayloadFormatUTF8,
+	); err != nil {
+		return err
+	}
+
+	if v := value.CorrelationData; v != nil {
+		if err := validate.Schema(validate.At(ctx, "correlationData"), v); err != nil {
+			return err
+		}
+	}
+
+	if v := value.ResponseTopic; v != nil {
+		if err := v.Validate(validate.At(ctx, "responseTopic")); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Mqtt5Server is defined in AsyncAPI spec: https://github.com/asyncapi/bindings/tree/master/mqtt5#server-binding-object
+type Mqtt5Server struct {
+	Extensions map[string]interface{} `json:"-" yaml:"-"`
+
+	SessionExpiryInterval *int   `json:"sessionExpiryInterval,omitempty" yaml:"sessionExpiryInterval,omitempty"`
+	BindingVersion        string `json:"bindingVersion,omitempty" yaml:"bindingVersion,omitempty"`
+}
+
+func (binding *Mqtt5Server) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{}, 2+len(binding.Extensions))
+	for k, v := range binding.Extensions {
+		m[k] = v
+	}
+
+	if binding.SessionExpiryInterval != nil {
+		m["sessionExpiryInterval"] = binding.SessionExpiryInterval
+	}
+	if len(binding.BindingVersion) != 0 {
+		m["bindingVersion"] = binding.BindingVersion
+	}
+
+	return json.Marshal(m)
+}
+
+func (binding *Mqtt5Server) UnmarshalJSON(data []byte) error {
+	type Mqtt5ServerBis Mqtt5Server
+	var x Mqtt5ServerBis
+	if err := json.Unmarshal(data, &x); err != nil {
+		return err
+	}
+	_ = json.Unmarshal(data, &x.Extensions)
+
+	delete(x.Extensions, "sessionExpiryInterval")
+	delete(x.Extensions, "bindingVersion")
+
+	*binding = Mqtt5Server(x)
+
+	return nil
+}
+
+func (binding *Mqtt5Server) Validate(ctx context.Context) error {
+	if err := validate.Extensions(ctx, binding.Extensions); err != nil {
+		return err
+	}
+
+	if err := validateBindingVersion(ctx, "mqtt5", binding.BindingVersion); err != nil {
+		return err
+	}
+
+	if v := binding.SessionExpiryInterval; v != nil && *v < 0 {
+		err := fmt.Errorf("sessionExpiryInterval must be greater than or equal to 0: %w", validate.ErrWrongField)
+		if err := validate.Report(validate.At(ctx, "sessionExpiryInterval"), validate.RuleValue, err); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Mqtt5Channel is defined in AsyncAPI spec: https://github.com/asyncapi/bindings/tree/master/mqtt5#channel-binding-object
+type Mqtt5Channel struct {
+	Extensions map[string]interface{} `json:"-" yaml:"-"`
+
+	BindingVersion string `json:"bindingVersion,omitempty" yaml:"bindingVersion,omitempty"`
+}
+
+func (binding *Mqtt5Channel) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{}, 1+len(binding.Extensions))
+	for k, v := range binding.Extensions {
+		m[k] = v
+	}
+
+	if len(binding.BindingVersion) != 0 {
+		m["bindingVersion"] = binding.BindingVersion
+	}
+
+	return json.Marshal(m)
+}
+
+func (binding *Mqtt5Channel) UnmarshalJSON(data []byte) error {
+	type Mqtt5ChannelBis Mqtt5Channel
+	var x Mqtt5ChannelBis
+	if err := json.Unmarshal(data, &x); err != nil {
+		return err
+	}
+	_ = json.Unmarshal(data, &x.Extensions)
+
+	delete(x.Extensions, "bindingVersion")
+
+	*binding = Mqtt5Channel(x)
+
+	return nil
+}
+
+func (binding *Mqtt5Channel) Validate(ctx context.Context) error {
+	if err := validate.Extensions(ctx, binding.Extensions); err != nil {
+		return err
+	}
+
+	if err := validateBindingVersion(ctx, "mqtt5", binding.BindingVersion); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Mqtt5Operation is defined in AsyncAPI spec: https://github.com/asyncapi/bindings/tree/master/mqtt5#operation-binding-object
+type Mqtt5Operation struct {
+	Extensions map[string]interface{} `json:"-" yaml:"-"`
+
+	BindingVersion string `json:"bindingVersion,omitempty" yaml:"bindingVersion,omitempty"`
+}
+
+func (binding *Mqtt5Operation) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{}, 1+len(binding.Extensions))
+	for k, v := range binding.Extensions {
+		m[k] = v
+	}
+
+	if len(binding.BindingVersion) != 0 {
+		m["bindingVersion"] = binding.BindingVersion
+	}
+
+	return json.Marshal(m)
+}
+
+func (binding *Mqtt5Operation) UnmarshalJSON(data []byte) error {
+	type Mqtt5OperationBis Mqtt5Operation
+	var x Mqtt5OperationBis
+	if err := json.Unmarshal(data, &x); err != nil {
+		return err
+	}
+	_ = json.Unmarshal(data, &x.Extensions)
+
+	delete(x.Extensions, "bindingVersion")
+
+	*binding = Mqtt5Operation(x)
+
+	return nil
+}
+
+func (binding *Mqtt5Operation) Validate(ctx context.Context) error {
+	if err := validate.Extensions(ctx, binding.Extensions); err != nil {
+		return err
+	}
+
+	if err := validateBindingVersion(ctx, "mqtt5", binding.BindingVersion); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Mqtt5Message is defined in AsyncAPI spec: https://github.com/asyncapi/bindings/tree/master/mqtt5#message-binding-object
+type Mqtt5Message struct {
+	Extensions map[string]interface{} `json:"-" yaml:"-"`
+
+	BindingVersion string `json:"bindingVersion,omitempty" yaml:"bindingVersion,omitempty"`
+}
+
+func (binding *Mqtt5Message) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{}, 1+len(binding.Extensions))
+	for k, v := range binding.Extensions {
+		m[k] = v
+	}
+
+	if len(binding.BindingVersion) != 0 {
+		m["bindingVersion"] = binding.BindingVersion
+	}
+
+	return json.Marshal(m)
+}
+
+func (binding *Mqtt5Message) UnmarshalJSON(data []byte) error {
+	type Mqtt5MessageBis Mqtt5Message
+	var x Mqtt5MessageBis
+	if err := json.Unmarshal(data, &x); err != nil {
+		return err
+	}
+	_ = json.Unmarshal(data, &x.Extensions)
+
+	delete(x.Extensions, "bindingVersion")
+
+	*binding = Mqtt5Message(x)
+
+	return nil
+}
+
+func (binding *Mqtt5Message) Validate(ctx context.Context) error {
+	if err := validate.Extensions(ctx, binding.Extensions); err != nil {
+		return err
+	}
+
+	if err := validateBindingVersion(ctx, "mqtt5", binding.BindingVersion); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func validateMqttQoS(ctx context.Context, qos *int) error {
+	if qos == nil || *qos >= MqttQoSAtMostOnce && *qos <= MqttQoSExactlyOnce {
+		return nil
+	}
+
+	err := fmt.Errorf("qos must be 0, 1 or 2: %w", validate.ErrWrongField)
+
+	return validate.Report(ctx, validate.RuleValue, err)
+}
+
+// validateMqttInteger checks that the integer value is at least min, or that the schema of values is valid.
+func validateMqttInteger(ctx context.Context, value *MqttValue[int], min int) error {
+	if value == nil {
+		return nil
+	}
+
+	if v := value.Value; v != nil && *v < min {
+		err := fmt.Errorf("value must be greater than or equal to %d: %w", min, validate.ErrWrongField)
+
+		return validate.Report(ctx, validate.RuleValue, err)
+	}
+
+	return value.Validate(ctx)
+}
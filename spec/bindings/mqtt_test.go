@@ -0,0 +1,141 @@
+package bindings
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/rdmrcv/go-asyncapi2/spec/validate"
+)
+
+func TestMqttServer_UnmarshalJSON(t *testing.T) {
+	data := []byte(`{
+		"clientId": "guest",
+		"cleanSession": true,
+		"lastWill": {"topic": "/last-wills", "qos": 0, "message": "Guest gone offline.", "retain": false},
+		"keepAlive": 60,
+		"bindingVersion": "0.1.0",
+		"x-fleet": "eu"
+	}`)
+
+	var binding MqttServer
+	if err := json.Unmarshal(data, &binding); err != nil {
+		t.Fatal(err)
+	}
+
+	if binding.LastWill == nil || binding.LastWill.QoS == nil || *binding.LastWill.QoS != MqttQoSAtMostOnce {
+		t.Errorf("unexpected last will %+v", binding.LastWill)
+	}
+
+	out, err := json.Marshal(&binding)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var expected, actual interface{}
+	_ = json.Unmarshal(data, &expected)
+	_ = json.Unmarshal(out, &actual)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("expected %s, got %s", data, out)
+	}
+}
+
+func TestMqttMessage_UnmarshalJSON(t *testing.T) {
+	data := []byte(`{
+		"payloadFormatIndicator": 1,
+		"correlationData": {"type": "string", "format": "uuid"},
+		"contentType": "application/json",
+		"responseTopic": "application/responses",
+		"bindingVersion": "0.2.0"
+	}`)
+
+	var value MqttMessage
+	if err := json.Unmarshal(data, &value); err != nil {
+		t.Fatal(err)
+	}
+
+	if value.ResponseTopic == nil || value.ResponseTopic.Value == nil || value.ResponseTopic.Schema != nil {
+		t.Errorf("unexpected response topic %+v", value.ResponseTopic)
+	}
+
+	if err := json.Unmarshal([]byte(`{"responseTopic": {"type": "string"}}`), &value); err != nil {
+		t.Fatal(err)
+	}
+
+	if value.ResponseTopic == nil || value.ResponseTopic.Value != nil || value.ResponseTopic.Schema == nil {
+		t.Errorf("unexpected response topic %+v", value.ResponseTopic)
+	}
+
+	if err := json.Unmarshal(data, &value); err != nil {
+		t.Fatal(err)
+	}
+
+	out, err := json.Marshal(&value)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var expected, actual interface{}
+	_ = json.Unmarshal(data, &expected)
+	_ = json.Unmarshal(out, &actual)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("expected %s, got %s", data, out)
+	}
+}
+
+func TestMqttBindings_Validate(t *testing.T) {
+	qos := func(v int) *int { return &v }
+
+	tests := []struct {
+		name    string
+		binding interface{ Validate(context.Context) error }
+		pointer string
+	}{
+		{
+			name:    "valid server",
+			binding: &MqttServer{LastWill: &MqttLastWill{QoS: qos(MqttQoSExactlyOnce)}},
+		},
+		{
+			name:    "last will qos",
+			binding: &MqttServer{LastWill: &MqttLastWill{QoS: qos(3)}},
+			pointer: "/lastWill/qos",
+		},
+		{
+			name:    "operation qos",
+			binding: &MqttOperation{QoS: qos(-1)},
+			pointer: "/qos",
+		},
+		{
+			name:    "session expiry interval",
+			binding: &Mqtt5Server{SessionExpiryInterval: qos(-1)},
+			pointer: "/sessionExpiryInterval",
+		},
+		{
+			name:    "mqtt5 channel binding version",
+			binding: &Mqtt5Channel{BindingVersion: "0.1.0"},
+			pointer: "/bindingVersion",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validate.Collect(context.Background(), tt.binding.Validate)
+			if tt.pointer == "" {
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				return
+			}
+
+			var errs validate.Errors
+			if !errors.As(err, &errs) || len(errs) != 1 || errs[0].Pointer != tt.pointer {
+				t.Fatalf("expected error at %s, got %v", tt.pointer, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,211 @@
+package bindings
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/rdmrcv/go-asyncapi2/spec/validate"
+)
+
+const natsQueueMaxLength = 255
+
+// NatsServer is defined in AsyncAPI spec: https://github.com/asyncapi/bindings/tree/master/nats#server-binding-object
+type NatsServer struct {
+	Extensions map[string]interface{} `json:"-" yaml:"-"`
+
+	BindingVersion string `json:"bindingVersion,omitempty" yaml:"bindingVersion,omitempty"`
+}
+
+func (binding *NatsServer) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{}, 1+len(binding.Extensions))
+	for k, v := range binding.Extensions {
+		m[k] = v
+	}
+
+	if len(binding.BindingVersion) != 0 {
+		m["bindingVersion"] = binding.BindingVersion
+	}
+
+	return json.Marshal(m)
+}
+
+func (binding *NatsServer) UnmarshalJSON(data []byte) error {
+	type NatsServerBis NatsServer
+	var x NatsServerBis
+	if err := json.Unmarshal(data, &x); err != nil {
+		return err
+	}
+	_ = json.Unmarshal(data, &x.Extensions)
+
+	delete(x.Extensions, "bindingVersion")
+
+	*binding = NatsServer(x)
+
+	return nil
+}
+
+func (binding *NatsServer) Validate(ctx context.Context) error {
+	if err := validate.Extensions(ctx, binding.Extensions); err != nil {
+		return err
+	}
+
+	if err := validateBindingVersion(ctx, "nats", binding.BindingVersion); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// NatsChannel is defined in AsyncAPI spec: https://github.com/asyncapi/bindings/tree/master/nats#channel-binding-object
+type NatsChannel struct {
+	Extensions map[string]interface{} `json:"-" yaml:"-"`
+
+	BindingVersion string `json:"bindingVersion,omitempty" yaml:"bindingVersion,omitempty"`
+}
+
+func (binding *NatsChannel) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{}, 1+len(binding.Extensions))
+	for k, v := range binding.Extensions {
+		m[k] = v
+	}
+
+	if len(binding.BindingVersion) != 0 {
+		m["bindingVersion"] = binding.BindingVersion
+	}
+
+	return json.Marshal(m)
+}
+
+func (binding *NatsChannel) UnmarshalJSON(data []byte) error {
+	type NatsChannelBis NatsChannel
+	var x NatsChannelBis
+	if err := json.Unmarshal(data, &x); err != nil {
+		return err
+	}
+	_ = json.Unmarshal(data, &x.Extensions)
+
+	delete(x.Extensions, "bindingVersion")
+
+	*binding = NatsChannel(x)
+
+	return nil
+}
+
+func (binding *NatsChannel) Validate(ctx context.Context) error {
+	if err := validate.Extensions(ctx, binding.Extensions); err != nil {
+		return err
+	}
+
+	if err := validateBindingVersion(ctx, "nats", binding.BindingVersion); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// NatsOperation is defined in AsyncAPI spec: https://github.com/asyncapi/bindings/tree/master/nats#operation-binding-object
+type NatsOperation struct {
+	Extensions map[string]interface{} `json:"-" yaml:"-"`
+
+	Queue          string `json:"queue,omitempty" yaml:"queue,omitempty"`
+	BindingVersion string `json:"bindingVersion,omitempty" yaml:"bindingVersion,omitempty"`
+}
+
+func (binding *NatsOperation) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{}, 2+len(binding.Extensions))
+	for k, v := range binding.Extensions {
+		m[k] = v
+	}
+
+	if len(binding.Queue) != 0 {
+		m["queue"] = binding.Queue
+	}
+	if len(binding.BindingVersion) != 0 {
+		m["bindingVersion"] = binding.BindingVersion
+	}
+
+	return json.Marshal(m)
+}
+
+func (binding *NatsOperation) UnmarshalJSON(data []byte) error {
+	type NatsOperationBis NatsOperation
+	var x NatsOperationBis
+	if err := json.Unmarshal(data, &x); err != nil {
+		return err
+	}
+	_ = json.Unmarshal(data, &x.Extensions)
+
+	delete(x.Extensions, "queue")
+	delete(x.Extensions, "bindingVersion")
+
+	*binding = NatsOperation(x)
+
+	return nil
+}
+
+func (binding *NatsOperation) Validate(ctx context.Context) error {
+	if err := validate.Extensions(ctx, binding.Extensions); err != nil {
+		return err
+	}
+
+	if err := validateBindingVersion(ctx, "nats", binding.BindingVersion); err != nil {
+		return err
+	}
+
+	if len(binding.Queue) > natsQueueMaxLength {
+		err := fmt.Errorf("queue must be at most %d characters: %w", natsQueueMaxLength, validate.ErrWrongField)
+		if err := validate.Report(validate.At(ctx, "queue"), validate.RuleValue, err); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// NatsMessage is defined in AsyncAPI spec: https://github.com/asyncapi/bindings/tree/master/nats#message-binding-object
+type NatsMessage struct {
+	Extensions map[string]interface{} `json:"-" yaml:"-"`
+
+	BindingVersion string `json:"bindingVersion,omitempty" yaml:"bindingVersion,omitempty"`
+}
+
+func (binding *NatsMessage) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{}, 1+len(binding.Extensions))
+	for k, v := range binding.Extensions {
+		m[k] = v
+	}
+
+	if len(binding.BindingVersion) != 0 {
+		m["bindingVersion"] = binding.BindingVersion
+	}
+
+	return json.Marshal(m)
+}
+
+func (binding *NatsMessage) UnmarshalJSON(data []byte) error {
+	type NatsMessageBis NatsMessage
+	var x NatsMessageBis
+	if err := json.Unmarshal(data, &x); err != nil {
+		return err
+	}
+	_ = json.Unmarshal(data, &x.Extensions)
+
+	delete(x.Extensions, "bindingVersion")
+
+	*binding = NatsMessage(x)
+
+	return nil
+}
+
+func (binding *NatsMessage) Validate(ctx context.Context) error {
+	if err := validate.Extensions(ctx, binding.Extensions); err != nil {
+		return err
+	}
+
+	if err := validateBindingVersion(ctx, "nats", binding.BindingVersion); err != nil {
+		return err
+	}
+
+	return nil
+}
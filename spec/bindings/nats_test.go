@@ -0,0 +1,58 @@
+package bindings
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestNatsOperation_UnmarshalJSON(t *testing.T) {
+	data := []byte(`{"queue": "messages", "bindingVersion": "0.1.0", "x-durable": true}`)
+
+	var binding NatsOperation
+	checkRoundTrip(t, data, &binding)
+
+	if binding.Queue != "messages" || binding.BindingVersion != "0.1.0" {
+		t.Errorf("unexpected binding %+v", binding)
+	}
+
+	if len(binding.Extensions) != 1 || binding.Extensions["x-durable"] != true {
+		t.Errorf("unexpected extensions %v", binding.Extensions)
+	}
+}
+
+func TestNatsBindings_UnmarshalJSON(t *testing.T) {
+	data := []byte(`{"bindingVersion": "0.1.0", "x-cluster": "east"}`)
+
+	for _, binding := range []interface{}{&NatsServer{}, &NatsChannel{}, &NatsMessage{}} {
+		checkRoundTrip(t, data, binding)
+	}
+}
+
+func TestNatsOperation_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		binding  interface{ Validate(context.Context) error }
+		pointers []string
+	}{
+		{
+			name:    "queue of 255 characters",
+			binding: &NatsOperation{Queue: strings.Repeat("q", 255)},
+		},
+		{
+			name:     "queue of 256 characters",
+			binding:  &NatsOperation{Queue: strings.Repeat("q", 256)},
+			pointers: []string{"/queue"},
+		},
+		{
+			name:    "without queue",
+			binding: &NatsOperation{BindingVersion: "0.1.0"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checkValidate(t, tt.binding, tt.pointers)
+		})
+	}
+}
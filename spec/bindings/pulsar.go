@@ -0,0 +1,236 @@
+package bindings
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+
+	"github.com/rdmrcv/go-asyncapi2/spec/validate"
+)
+
+type PulsarPersistence string
+
+const (
+	PulsarPersistent    PulsarPersistence = "persistent"
+	PulsarNonPersistent PulsarPersistence = "non-persistent"
+)
+
+// PulsarServer is defined in AsyncAPI spec: https://github.com/asyncapi/bindings/tree/master/pulsar#server-binding-object
+type PulsarServer struct {
+	Extensions map[string]interface{} `json:"-" yaml:"-"`
+
+	Tenant         string `json:"tenant,omitempty" yaml:"tenant,omitempty"`
+	BindingVersion string `json:"bindingVersion,omitempty" yaml:"bindingVersion,omitempty"`
+}
+
+func (binding *PulsarServer) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{}, 2+len(binding.Extensions))
+	for k, v := range binding.Extensions {
+		m[k] = v
+	}
+
+	if len(binding.Tenant) != 0 {
+		m["tenant"] = binding.Tenant
+	}
+	if len(binding.BindingVersion) != 0 {
+		m["bindingVersion"] = binding.BindingVersion
+	}
+
+	return json.Marshal(m)
+}
+
+func (binding *PulsarServer) UnmarshalJSON(data []byte) error {
+	type PulsarServerBis PulsarServer
+	var x PulsarServerBis
+	if err := json.Unmarshal(data, &x); err != nil {
+		return err
+	}
+	_ = json.Unmarshal(data, &x.Extensions)
+
+	delete(x.Extensions, "tenant")
+	delete(x.Extensions, "bindingVersion")
+
+	*binding = PulsarServer(x)
+
+	return nil
+}
+
+func (binding *PulsarServer) Validate(ctx context.Context) error {
+	if err := validate.Extensions(ctx, binding.Extensions); err != nil {
+		return err
+	}
+
+	if err := validateBindingVersion(ctx, "pulsar", binding.BindingVersion); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// PulsarChannel is defined in AsyncAPI spec: https://github.com/asyncapi/bindings/tree/master/pulsar#channel-binding-object
+type PulsarChannel struct {
+	Extensions map[string]interface{} `json:"-" yaml:"-"`
+
+	Namespace      string            `json:"namespace,omitempty" yaml:"namespace,omitempty"`
+	Persistence    PulsarPersistence `json:"persistence,omitempty" yaml:"persistence,omitempty"`
+	Compaction     *int              `json:"compaction,omitempty" yaml:"compaction,omitempty"`
+	GeoReplication []string          `json:"geo-replication,omitempty" yaml:"geo-replication,omitempty"`
+	Retention      *PulsarRetention  `json:"retention,omitempty" yaml:"retention,omitempty"`
+	TTL            *int              `json:"ttl,omitempty" yaml:"ttl,omitempty"`
+	Deduplication  *bool             `json:"deduplication,omitempty" yaml:"deduplication,omitempty"`
+	BindingVersion string            `json:"bindingVersion,omitempty" yaml:"bindingVersion,omitempty"`
+}
+
+func (binding *PulsarChannel) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{}, 8+len(binding.Extensions))
+	for k, v := range binding.Extensions {
+		m[k] = v
+	}
+
+	if len(binding.Namespace) != 0 {
+		m["namespace"] = binding.Namespace
+	}
+	if len(binding.Persistence) != 0 {
+		m["persistence"] = binding.Persistence
+	}
+	if binding.Compaction != nil {
+		m["compaction"] = binding.Compaction
+	}
+	if len(binding.GeoReplication) != 0 {
+		m["geo-replication"] = binding.GeoReplication
+	}
+	if binding.Retention != nil {
+		m["retention"] = binding.Retention
+	}
+	if binding.TTL != nil {
+		m["ttl"] = binding.TTL
+	}
+	if binding.Deduplication != nil {
+		m["deduplication"] = binding.Deduplication
+	}
+	if len(binding.BindingVersion) != 0 {
+		m["bindingVersion"] = binding.BindingVersion
+	}
+
+	return json.Marshal(m)
+}
+
+func (binding *PulsarChannel) UnmarshalJSON(data []byte) error {
+	type PulsarChannelBis PulsarChannel
+	var x PulsarChannelBis
+	if err := json.Unmarshal(data, &x); err != nil {
+		return err
+	}
+	_ = json.Unmarshal(data, &x.Extensions)
+
+	delete(x.Extensions, "namespace")
+	delete(x.Extensions, "persistence")
+	delete(x.Extensions, "compaction")
+	delete(x.Extensions, "geo-replication")
+	delete(x.Extensions, "retention")
+	delete(x.Extensions, "ttl")
+	delete(x.Extensions, "deduplication")
+	delete(x.Extensions, "bindingVersion")
+
+	*binding = PulsarChannel(x)
+
+	return nil
+}
+
+func (binding *PulsarChannel) Validate(ctx context.Context) error {
+	if err := validate.Extensions(ctx, binding.Extensions); err != nil {
+		return err
+	}
+
+	if err := validateBindingVersion(ctx, "pulsar", binding.BindingVersion); err != nil {
+		return err
+	}
+
+	if binding.Namespace == "" {
+		err := fmt.Errorf("value of namespace must be a non-empty string: %w", validate.ErrWrongField)
+		if err := validate.Report(validate.At(ctx, "namespace"), validate.RuleRequired, err); err != nil {
+			return err
+		}
+	}
+
+	switch binding.Persistence {
+	case PulsarPersistent, PulsarNonPersistent:
+	case "":
+		err := fmt.Errorf("value of persistence must be a non-empty string: %w", validate.ErrWrongField)
+		if err := validate.Report(validate.At(ctx, "persistence"), validate.RuleRequired, err); err != nil {
+			return err
+		}
+	default:
+		err := fmt.Errorf("persistence must be either persistent or non-persistent: %w", validate.ErrWrongField)
+		if err := validate.Report(validate.At(ctx, "persistence"), validate.RuleValue, err); err != nil {
+			return err
+		}
+	}
+
+	if err := validateRange(validate.At(ctx, "compaction"), binding.Compaction, 0, math.MaxInt32); err != nil {
+		return err
+	}
+
+	if err := validateRange(validate.At(ctx, "ttl"), binding.TTL, 0, math.MaxInt32); err != nil {
+		return err
+	}
+
+	if v := binding.Retention; v != nil {
+		ctx := validate.At(ctx, "retention")
+
+		if err := validate.Extensions(ctx, v.Extensions); err != nil {
+			return err
+		}
+
+		if err := validateRange(validate.At(ctx, "time"), v.Time, 0, math.MaxInt32); err != nil {
+			return err
+		}
+
+		if err := validateRange(validate.At(ctx, "size"), v.Size, 0, math.MaxInt32); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// PulsarRetention describes how long and how much of the topic is retained.
+type PulsarRetention struct {
+	Extensions map[string]interface{} `json:"-" yaml:"-"`
+
+	Time *int `json:"time,omitempty" yaml:"time,omitempty"`
+	Size *int `json:"size,omitempty" yaml:"size,omitempty"`
+}
+
+func (value *PulsarRetention) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{}, 2+len(value.Extensions))
+	for k, v := range value.Extensions {
+		m[k] = v
+	}
+
+	if value.Time != nil {
+		m["time"] = value.Time
+	}
+	if value.Size != nil {
+		m["size"] = value.Size
+	}
+
+	return json.Marshal(m)
+}
+
+func (value *PulsarRetention) UnmarshalJSON(data []byte) error {
+	type PulsarRetentionBis PulsarRetention
+	var x PulsarRetentionBis
+	if err := json.Unmarshal(data, &x); err != nil {
+		return err
+	}
+	_ = json.Unmarshal(data, &x.Extensions)
+
+	delete(x.Extensions, "time")
+	delete(x.Extensions, "size")
+
+	*value = PulsarRetention(x)
+
+	return nil
+}
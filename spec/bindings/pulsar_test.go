@@ -0,0 +1,89 @@
+package bindings
+
+import (
+	"context"
+	"testing"
+)
+
+func TestPulsarBindings_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		binding interface{}
+	}{
+		{
+			name:    "server",
+			data:    `{"tenant": "contoso", "bindingVersion": "0.1.0", "x-cluster": "eu"}`,
+			binding: &PulsarServer{},
+		},
+		{
+			name: "channel",
+			data: `{
+				"namespace": "staging",
+				"persistence": "persistent",
+				"compaction": 1000,
+				"geo-replication": ["us-east1", "us-west1"],
+				"retention": {"time": 7, "size": 1000, "x-unit": "days"},
+				"ttl": 360,
+				"deduplication": false,
+				"bindingVersion": "0.1.0",
+				"x-cluster": "eu"
+			}`,
+			binding: &PulsarChannel{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checkRoundTrip(t, []byte(tt.data), tt.binding)
+		})
+	}
+}
+
+func TestPulsarChannel_Validate(t *testing.T) {
+	number := func(v int) *int { return &v }
+
+	tests := []struct {
+		name     string
+		binding  interface{ Validate(context.Context) error }
+		pointers []string
+	}{
+		{
+			name: "channel",
+			binding: &PulsarChannel{
+				Namespace:   "staging",
+				Persistence: PulsarNonPersistent,
+				Compaction:  number(0),
+				Retention:   &PulsarRetention{Time: number(0), Size: number(0)},
+				TTL:         number(0),
+			},
+		},
+		{
+			name:     "without namespace and persistence",
+			binding:  &PulsarChannel{},
+			pointers: []string{"/namespace", "/persistence"},
+		},
+		{
+			name:     "unknown persistence",
+			binding:  &PulsarChannel{Namespace: "staging", Persistence: "durable"},
+			pointers: []string{"/persistence"},
+		},
+		{
+			name: "negative values",
+			binding: &PulsarChannel{
+				Namespace:   "staging",
+				Persistence: PulsarPersistent,
+				Compaction:  number(-1),
+				Retention:   &PulsarRetention{Time: number(-1), Size: number(-1)},
+				TTL:         number(-1),
+			},
+			pointers: []string{"/compaction", "/ttl", "/retention/time", "/retention/size"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checkValidate(t, tt.binding, tt.pointers)
+		})
+	}
+}
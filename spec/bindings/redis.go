@@ -0,0 +1,196 @@
+package bindings
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/rdmrcv/go-asyncapi2/spec/validate"
+)
+
+// RedisServer is defined in AsyncAPI spec: https://github.com/asyncapi/bindings/tree/master/redis#server-binding-object
+type RedisServer struct {
+	Extensions map[string]interface{} `json:"-" yaml:"-"`
+
+	BindingVersion string `json:"bindingVersion,omitempty" yaml:"bindingVersion,omitempty"`
+}
+
+func (binding *RedisServer) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{}, 1+len(binding.Extensions))
+	for k, v := range binding.Extensions {
+		m[k] = v
+	}
+
+	if len(binding.BindingVersion) != 0 {
+		m["bindingVersion"] = binding.BindingVersion
+	}
+
+	return json.Marshal(m)
+}
+
+func (binding *RedisServer) UnmarshalJSON(data []byte) error {
+	type RedisServerBis RedisServer
+	var x RedisServerBis
+	if err := json.Unmarshal(data, &x); err != nil {
+		return err
+	}
+	_ = json.Unmarshal(data, &x.Extensions)
+
+	delete(x.Extensions, "bindingVersion")
+
+	*binding = RedisServer(x)
+
+	return nil
+}
+
+func (binding *RedisServer) Validate(ctx context.Context) error {
+	if err := validate.Extensions(ctx, binding.Extensions); err != nil {
+		return err
+	}
+
+	if err := validateBindingVersion(ctx, "redis", binding.BindingVersion); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// RedisChannel is defined in AsyncAPI spec: https://github.com/asyncapi/bindings/tree/master/redis#channel-binding-object
+type RedisChannel struct {
+	Extensions map[string]interface{} `json:"-" yaml:"-"`
+
+	BindingVersion string `json:"bindingVersion,omitempty" yaml:"bindingVersion,omitempty"`
+}
+
+func (binding *RedisChannel) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{}, 1+len(binding.Extensions))
+	for k, v := range binding.Extensions {
+		m[k] = v
+	}
+
+	if len(binding.BindingVersion) != 0 {
+		m["bindingVersion"] = binding.BindingVersion
+	}
+
+	return json.Marshal(m)
+}
+
+func (binding *RedisChannel) UnmarshalJSON(data []byte) error {
+	type RedisChannelBis RedisChannel
+	var x RedisChannelBis
+	if err := json.Unmarshal(data, &x); err != nil {
+		return err
+	}
+	_ = json.Unmarshal(data, &x.Extensions)
+
+	delete(x.Extensions, "bindingVersion")
+
+	*binding = RedisChannel(x)
+
+	return nil
+}
+
+func (binding *RedisChannel) Validate(ctx context.Context) error {
+	if err := validate.Extensions(ctx, binding.Extensions); err != nil {
+		return err
+	}
+
+	if err := validateBindingVersion(ctx, "redis", binding.BindingVersion); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// RedisOperation is defined in AsyncAPI spec: https://github.com/asyncapi/bindings/tree/master/redis#operation-binding-object
+type RedisOperation struct {
+	Extensions map[string]interface{} `json:"-" yaml:"-"`
+
+	BindingVersion string `json:"bindingVersion,omitempty" yaml:"bindingVersion,omitempty"`
+}
+
+func (binding *RedisOperation) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{}, 1+len(binding.Extensions))
+	for k, v := range binding.Extensions {
+		m[k] = v
+	}
+
+	if len(binding.BindingVersion) != 0 {
+		m["bindingVersion"] = binding.BindingVersion
+	}
+
+	return json.Marshal(m)
+}
+
+func (binding *RedisOperation) UnmarshalJSON(data []byte) error {
+	type RedisOperationBis RedisOperation
+	var x RedisOperationBis
+	if err := json.Unmarshal(data, &x); err != nil {
+		return err
+	}
+	_ = json.Unmarshal(data, &x.Extensions)
+
+	delete(x.Extensions, "bindingVersion")
+
+	*binding = RedisOperation(x)
+
+	return nil
+}
+
+func (binding *RedisOperation) Validate(ctx context.Context) error {
+	if err := validate.Extensions(ctx, binding.Extensions); err != nil {
+		return err
+	}
+
+	if err := validateBindingVersion(ctx, "redis", binding.BindingVersion); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// RedisMessage is defined in AsyncAPI spec: https://github.com/asyncapi/bindings/tree/master/redis#message-binding-object
+type RedisMessage struct {
+	Extensions map[string]interface{} `json:"-" yaml:"-"`
+
+	BindingVersion string `json:"bindingVersion,omitempty" yaml:"bindingVersion,omitempty"`
+}
+
+func (binding *RedisMessage) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{}, 1+len(binding.Extensions))
+	for k, v := range binding.Extensions {
+		m[k] = v
+	}
+
+	if len(binding.BindingVersion) != 0 {
+		m["bindingVersion"] = binding.BindingVersion
+	}
+
+	return json.Marshal(m)
+}
+
+func (binding *RedisMessage) UnmarshalJSON(data []byte) error {
+	type RedisMessageBis RedisMessage
+	var x RedisMessageBis
+	if err := json.Unmarshal(data, &x); err != nil {
+		return err
+	}
+	_ = json.Unmarshal(data, &x.Extensions)
+
+	delete(x.Extensions, "bindingVersion")
+
+	*binding = RedisMessage(x)
+
+	return nil
+}
+
+func (binding *RedisMessage) Validate(ctx context.Context) error {
+	if err := validate.Extensions(ctx, binding.Extensions); err != nil {
+		return err
+	}
+
+	if err := validateBindingVersion(ctx, "redis", binding.BindingVersion); err != nil {
+		return err
+	}
+
+	return nil
+}
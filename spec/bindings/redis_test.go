@@ -0,0 +1,18 @@
+package bindings
+
+import (
+	"testing"
+)
+
+func TestRedisBindings_UnmarshalJSON(t *testing.T) {
+	data := []byte(`{"bindingVersion": "0.1.0", "x-database": 2}`)
+
+	bindings := []interface{}{&RedisServer{}, &RedisChannel{}, &RedisOperation{}, &RedisMessage{}}
+	for _, binding := range bindings {
+		checkRoundTrip(t, data, binding)
+	}
+
+	if v := bindings[2].(*RedisOperation); v.BindingVersion != "0.1.0" || v.Extensions["x-database"] != float64(2) {
+		t.Errorf("unexpected binding %+v", v)
+	}
+}
@@ -0,0 +1,80 @@
+package bindings
+
+import (
+	"context"
+	"sync"
+)
+
+// Binding is a binding object of a protocol.
+type Binding interface {
+	Validate(ctx context.Context) error
+}
+
+// Protocol holds constructors of binding objects of a protocol. Constructors return pointers
+// binding objects are decoded into with encoding/json. A nil constructor means the protocol defines
+// no binding object of the kind, such objects stay in extensions.
+type Protocol struct {
+	Server    func() Binding
+	Channel   func() Binding
+	Operation func() Binding
+	Message   func() Binding
+}
+
+var (
+	protocolsMu sync.RWMutex
+	protocols   = map[string]Protocol{}
+)
+
+// builtinProtocols are protocols with typed fields in bindings objects.
+var builtinProtocols = map[string]struct{}{
+	"http":         {},
+	"ws":           {},
+	"kafka":        {},
+	"amqp":         {},
+	"amqp1":        {},
+	"mqtt":         {},
+	"mqtt5":        {},
+	"nats":         {},
+	"jms":          {},
+	"sns":          {},
+	"sqs":          {},
+	"stomp":        {},
+	"redis":        {},
+	"ibmmq":        {},
+	"googlepubsub": {},
+	"solace":       {},
+	"pulsar":       {},
+}
+
+// RegisterProtocol registers bindings of the protocol, so bindings objects decode them into typed values.
+//
+// Protocols with typed fields in bindings objects, like kafka, are always decoded into these fields,
+// so RegisterProtocol panics when the name is one of them.
+func RegisterProtocol(name string, protocol Protocol) {
+	if _, ok := builtinProtocols[name]; ok {
+		panic("bindings: RegisterProtocol called for built-in protocol " + name)
+	}
+
+	protocolsMu.Lock()
+	defer protocolsMu.Unlock()
+
+	protocols[name] = protocol
+}
+
+// UnregisterProtocol removes bindings of the protocol.
+func UnregisterProtocol(name string) {
+	protocolsMu.Lock()
+	defer protocolsMu.Unlock()
+
+	delete(protocols, name)
+}
+
+// RegisteredProtocol returns bindings of the protocol.
+func RegisteredProtocol(name string) (Protocol, bool) {
+	protocolsMu.RLock()
+	defer protocolsMu.RUnlock()
+
+	protocol, ok := protocols[name]
+
+	return protocol, ok
+}
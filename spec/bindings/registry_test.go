@@ -0,0 +1,44 @@
+package bindings
+
+import (
+	"context"
+	"testing"
+)
+
+type inhouseChannel struct{}
+
+func (*inhouseChannel) Validate(context.Context) error {
+	return nil
+}
+
+func TestRegisterProtocol(t *testing.T) {
+	RegisterProtocol("inhouse", Protocol{Channel: func() Binding { return &inhouseChannel{} }})
+
+	if protocol, ok := RegisteredProtocol("inhouse"); !ok || protocol.Channel == nil {
+		t.Fatal("expected the registered protocol")
+	}
+
+	UnregisterProtocol("inhouse")
+
+	if _, ok := RegisteredProtocol("inhouse"); ok {
+		t.Fatal("expected the protocol to be unregistered")
+	}
+}
+
+func TestRegisterProtocol_Builtin(t *testing.T) {
+	for _, name := range []string{"kafka", "http", "pulsar"} {
+		t.Run(name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Error("expected a panic")
+				}
+
+				if _, ok := RegisteredProtocol(name); ok {
+					t.Error("expected the protocol not to be registered")
+				}
+			}()
+
+			RegisterProtocol(name, Protocol{Channel: func() Binding { return &inhouseChannel{} }})
+		})
+	}
+}
@@ -0,0 +1,769 @@
+package bindings
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/rdmrcv/go-asyncapi2/spec/validate"
+)
+
+type SnsOrderingType string
+
+const (
+	SnsOrderingStandard SnsOrderingType = "standard"
+	SnsOrderingFifo     SnsOrderingType = "FIFO"
+)
+
+type SnsProtocol string
+
+const (
+	SnsProtocolHttp        SnsProtocol = "http"
+	SnsProtocolHttps       SnsProtocol = "https"
+	SnsProtocolEmail       SnsProtocol = "email"
+	SnsProtocolEmailJSON   SnsProtocol = "email-json"
+	SnsProtocolSms         SnsProtocol = "sms"
+	SnsProtocolSqs         SnsProtocol = "sqs"
+	SnsProtocolApplication SnsProtocol = "application"
+	SnsProtocolLambda      SnsProtocol = "lambda"
+	SnsProtocolFirehose    SnsProtocol = "firehose"
+)
+
+var snsProtocols = map[SnsProtocol]struct{}{
+	SnsProtocolHttp:        {},
+	SnsProtocolHttps:       {},
+	SnsProtocolEmail:       {},
+	SnsProtocolEmailJSON:   {},
+	SnsProtocolSms:         {},
+	SnsProtocolSqs:         {},
+	SnsProtocolApplication: {},
+	SnsProtocolLambda:      {},
+	SnsProtocolFirehose:    {},
+}
+
+type SnsFilterPolicyScope string
+
+const (
+	SnsFilterPolicyScopeMessageAttributes SnsFilterPolicyScope = "MessageAttributes"
+	SnsFilterPolicyScopeMessageBody       SnsFilterPolicyScope = "MessageBody"
+)
+
+type SnsBackoffFunction string
+
+const (
+	SnsBackoffArithmetic  SnsBackoffFunction = "arithmetic"
+	SnsBackoffExponential SnsBackoffFunction = "exponential"
+	SnsBackoffGeometric   SnsBackoffFunction = "geometric"
+	SnsBackoffLinear      SnsBackoffFunction = "linear"
+)
+
+// SnsServer is defined in AsyncAPI spec: https://github.com/asyncapi/bindings/tree/master/sns#server-binding-object
+type SnsServer struct {
+	Extensions map[string]interface{} `json:"-" yaml:"-"`
+
+	BindingVersion string `json:"bindingVersion,omitempty" yaml:"bindingVersion,omitempty"`
+}
+
+func (binding *SnsServer) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{}, 1+len(binding.Extensions))
+	for k, v := range binding.Extensions {
+		m[k] = v
+	}
+
+	if len(binding.BindingVersion) != 0 {
+		m["bindingVersion"] = binding.BindingVersion
+	}
+
+	return json.Marshal(m)
+}
+
+func (binding *SnsServer) UnmarshalJSON(data []byte) error {
+	type SnsServerBis SnsServer
+	var x SnsServerBis
+	if err := json.Unmarshal(data, &x); err != nil {
+		return err
+	}
+	_ = json.Unmarshal(data, &x.Extensions)
+
+	delete(x.Extensions, "bindingVersion")
+
+	*binding = SnsServer(x)
+
+	return nil
+}
+
+func (binding *SnsServer) Validate(ctx context.Context) error {
+	if err := validate.Extensions(ctx, binding.Extensions); err != nil {
+		return err
+	}
+
+	if err := validateBindingVersion(ctx, "sns", binding.BindingVersion); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SnsChannel is defined in AsyncAPI spec: https://github.com/asyncapi/bindings/tree/master/sns#channel-binding-object
+type SnsChannel struct {
+	Extensions map[string]interface{} `json:"-" yaml:"-"`
+
+	Name           string            `json:"name,omitempty" yaml:"name,omitempty"`
+	Ordering       *SnsOrdering      `json:"ordering,omitempty" yaml:"ordering,omitempty"`
+	Policy         *AwsPolicy        `json:"policy,omitempty" yaml:"policy,omitempty"`
+	Tags           map[string]string `json:"tags,omitempty" yaml:"tags,omitempty"`
+	BindingVersion string            `json:"bindingVersion,omitempty" yaml:"bindingVersion,omitempty"`
+}
+
+func (binding *SnsChannel) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{}, 5+len(binding.Extensions))
+	for k, v := range binding.Extensions {
+		m[k] = v
+	}
+
+	if len(binding.Name) != 0 {
+		m["name"] = binding.Name
+	}
+	if binding.Ordering != nil {
+		m["ordering"] = binding.Ordering
+	}
+	if binding.Policy != nil {
+		m["policy"] = binding.Policy
+	}
+	if len(binding.Tags) != 0 {
+		m["tags"] = binding.Tags
+	}
+	if len(binding.BindingVersion) != 0 {
+		m["bindingVersion"] = binding.BindingVersion
+	}
+
+	return json.Marshal(m)
+}
+
+func (binding *SnsChannel) UnmarshalJSON(data []byte) error {
+	type SnsChannelBis SnsChannel
+	var x SnsChannelBis
+	if err := json.Unmarshal(data, &x); err != nil {
+		return err
+	}
+	_ = json.Unmarshal(data, &x.Extensions)
+
+	delete(x.Extensions, "name")
+	delete(x.Extensions, "ordering")
+	delete(x.Extensions, "policy")
+	delete(x.Extensions, "tags")
+	delete(x.Extensions, "bindingVersion")
+
+	*binding = SnsChannel(x)
+
+	return nil
+}
+
+func (binding *SnsChannel) Validate(ctx context.Context) error {
+	if err := validate.Extensions(ctx, binding.Extensions); err != nil {
+		return err
+	}
+
+	if err := validateBindingVersion(ctx, "sns", binding.BindingVersion); err != nil {
+		return err
+	}
+
+	if binding.Name == "" {
+		err := fmt.Errorf("value of name must be a non-empty string: %w", validate.ErrWrongField)
+		if err := validate.Report(validate.At(ctx, "name"), validate.RuleRequired, err); err != nil {
+			return err
+		}
+	}
+
+	if v := binding.Ordering; v != nil {
+		if err := v.Validate(validate.At(ctx, "ordering")); err != nil {
+			return err
+		}
+	}
+
+	if v := binding.Policy; v != nil {
+		if err := v.Validate(validate.At(ctx, "policy")); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SnsOrdering describes the ordering of messages of the topic.
+type SnsOrdering struct {
+	Extensions map[string]interface{} `json:"-" yaml:"-"`
+
+	Type                      SnsOrderingType `json:"type,omitempty" yaml:"type,omitempty"`
+	ContentBasedDeduplication *bool           `json:"contentBasedDeduplication,omitempty" yaml:"contentBasedDeduplication,omitempty"`
+}
+
+func (value *SnsOrdering) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{}, 2+len(value.Extensions))
+	for k, v := range value.Extensions {
+		m[k] = v
+	}
+
+	if len(value.Type) != 0 {
+		m["type"] = value.Type
+	}
+	if value.ContentBasedDeduplication != nil {
+		m["contentBasedDeduplication"] = value.ContentBasedDeduplication
+	}
+
+	return json.Marshal(m)
+}
+
+func (value *SnsOrdering) UnmarshalJSON(data []byte) error {
+	type SnsOrderingBis SnsOrdering
+	var x SnsOrderingBis
+	if err := json.Unmarshal(data, &x); err != nil {
+		return err
+	}
+	_ = json.Unmarshal(data, &x.Extensions)
+
+	delete(x.Extensions, "type")
+	delete(x.Extensions, "contentBasedDeduplication")
+
+	*value = SnsOrdering(x)
+
+	return nil
+}
+
+func (value *SnsOrdering) Validate(ctx context.Context) error {
+	if err := validate.Extensions(ctx, value.Extensions); err != nil {
+		return err
+	}
+
+	switch value.Type {
+	case SnsOrderingStandard, SnsOrderingFifo:
+	case "":
+		err := fmt.Errorf("value of type must be a non-empty string: %w", validate.ErrWrongField)
+		if err := validate.Report(validate.At(ctx, "type"), validate.RuleRequired, err); err != nil {
+			return err
+		}
+	default:
+		err := fmt.Errorf("type must be either standard or FIFO: %w", validate.ErrWrongField)
+		if err := validate.Report(validate.At(ctx, "type"), validate.RuleValue, err); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SnsOperation is defined in AsyncAPI spec: https://github.com/asyncapi/bindings/tree/master/sns#operation-binding-object
+type SnsOperation struct {
+	Extensions map[string]interface{} `json:"-" yaml:"-"`
+
+	Topic          *SnsIdentifier     `json:"topic,omitempty" yaml:"topic,omitempty"`
+	Consumers      []*SnsConsumer     `json:"consumers,omitempty" yaml:"consumers,omitempty"`
+	DeliveryPolicy *SnsDeliveryPolicy `json:"deliveryPolicy,omitempty" yaml:"deliveryPolicy,omitempty"`
+	BindingVersion string             `json:"bindingVersion,omitempty" yaml:"bindingVersion,omitempty"`
+}
+
+func (binding *SnsOperation) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{}, 4+len(binding.Extensions))
+	for k, v := range binding.Extensions {
+		m[k] = v
+	}
+
+	if binding.Topic != nil {
+		m["topic"] = binding.Topic
+	}
+	if len(binding.Consumers) != 0 {
+		m["consumers"] = binding.Consumers
+	}
+	if binding.DeliveryPolicy != nil {
+		m["deliveryPolicy"] = binding.DeliveryPolicy
+	}
+	if len(binding.BindingVersion) != 0 {
+		m["bindingVersion"] = binding.BindingVersion
+	}
+
+	return json.Marshal(m)
+}
+
+func (binding *SnsOperation) UnmarshalJSON(data []byte) error {
+	type SnsOperationBis SnsOperation
+	var x SnsOperationBis
+	if err := json.Unmarshal(data, &x); err != nil {
+		return err
+	}
+	_ = json.Unmarshal(data, &x.Extensions)
+
+	delete(x.Extensions, "topic")
+	delete(x.Extensions, "consumers")
+	delete(x.Extensions, "deliveryPolicy")
+	delete(x.Extensions, "bindingVersion")
+
+	*binding = SnsOperation(x)
+
+	return nil
+}
+
+func (binding *SnsOperation) Validate(ctx context.Context) error {
+	if err := validate.Extensions(ctx, binding.Extensions); err != nil {
+		return err
+	}
+
+	if err := validateBindingVersion(ctx, "sns", binding.BindingVersion); err != nil {
+		return err
+	}
+
+	if v := binding.Topic; v != nil {
+		if err := v.Validate(validate.At(ctx, "topic")); err != nil {
+			return err
+		}
+	}
+
+	if len(binding.Consumers) == 0 {
+		err := fmt.Errorf("consumers must have at least one consumer: %w", validate.ErrWrongField)
+		if err := validate.Report(validate.At(ctx, "consumers"), validate.RuleRequired, err); err != nil {
+			return err
+		}
+	}
+
+	for i, v := range binding.Consumers {
+		if v == nil {
+			continue
+		}
+
+		if err := v.Validate(validate.At(ctx, "consumers", strconv.Itoa(i))); err != nil {
+			return err
+		}
+	}
+
+	if v := binding.DeliveryPolicy; v != nil {
+		if err := v.Validate(validate.At(ctx, "deliveryPolicy")); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SnsIdentifier identifies a topic or an endpoint by one of its fields.
+type SnsIdentifier struct {
+	Extensions map[string]interface{} `json:"-" yaml:"-"`
+
+	URL   string `json:"url,omitempty" yaml:"url,omitempty"`
+	Email string `json:"email,omitempty" yaml:"email,omitempty"`
+	Phone string `json:"phone,omitempty" yaml:"phone,omitempty"`
+	ARN   string `json:"arn,omitempty" yaml:"arn,omitempty"`
+	Name  string `json:"name,omitempty" yaml:"name,omitempty"`
+}
+
+func (value *SnsIdentifier) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{}, 5+len(value.Extensions))
+	for k, v := range value.Extensions {
+		m[k] = v
+	}
+
+	if len(value.URL) != 0 {
+		m["url"] = value.URL
+	}
+	if len(value.Email) != 0 {
+		m["email"] = value.Email
+	}
+	if len(value.Phone) != 0 {
+		m["phone"] = value.Phone
+	}
+	if len(value.ARN) != 0 {
+		m["arn"] = value.ARN
+	}
+	if len(value.Name) != 0 {
+		m["name"] = value.Name
+	}
+
+	return json.Marshal(m)
+}
+
+func (value *SnsIdentifier) UnmarshalJSON(data []byte) error {
+	type SnsIdentifierBis SnsIdentifier
+	var x SnsIdentifierBis
+	if err := json.Unmarshal(data, &x); err != nil {
+		return err
+	}
+	_ = json.Unmarshal(data, &x.Extensions)
+
+	delete(x.Extensions, "url")
+	delete(x.Extensions, "email")
+	delete(x.Extensions, "phone")
+	delete(x.Extensions, "arn")
+	delete(x.Extensions, "name")
+
+	*value = SnsIdentifier(x)
+
+	return nil
+}
+
+func (value *SnsIdentifier) Validate(ctx context.Context) error {
+	if err := validate.Extensions(ctx, value.Extensions); err != nil {
+		return err
+	}
+
+	if value.URL == "" && value.Email == "" && value.Phone == "" && value.ARN == "" && value.Name == "" {
+		err := fmt.Errorf("one of url, email, phone, arn or name must be set: %w", validate.ErrWrongField)
+		if err := validate.Report(ctx, validate.RuleRequired, err); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SnsConsumer describes a subscription to the topic.
+type SnsConsumer struct {
+	Extensions map[string]interface{} `json:"-" yaml:"-"`
+
+	Protocol           SnsProtocol            `json:"protocol,omitempty" yaml:"protocol,omitempty"`
+	Endpoint           *SnsIdentifier         `json:"endpoint,omitempty" yaml:"endpoint,omitempty"`
+	FilterPolicy       map[string]interface{} `json:"filterPolicy,omitempty" yaml:"filterPolicy,omitempty"`
+	FilterPolicyScope  SnsFilterPolicyScope   `json:"filterPolicyScope,omitempty" yaml:"filterPolicyScope,omitempty"`
+	RawMessageDelivery *bool                  `json:"rawMessageDelivery,omitempty" yaml:"rawMessageDelivery,omitempty"`
+	RedrivePolicy      *SnsRedrivePolicy      `json:"redrivePolicy,omitempty" yaml:"redrivePolicy,omitempty"`
+	DeliveryPolicy     *SnsDeliveryPolicy     `json:"deliveryPolicy,omitempty" yaml:"deliveryPolicy,omitempty"`
+	DisplayName        string                 `json:"displayName,omitempty" yaml:"displayName,omitempty"`
+}
+
+func (value *SnsConsumer) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{}, 8+len(value.Extensions))
+	for k, v := range value.Extensions {
+		m[k] = v
+	}
+
+	if len(value.Protocol) != 0 {
+		m["protocol"] = value.Protocol
+	}
+	if value.Endpoint != nil {
+		m["endpoint"] = value.Endpoint
+	}
+	if len(value.FilterPolicy) != 0 {
+		m["filterPolicy"] = value.FilterPolicy
+	}
+	if len(value.FilterPolicyScope) != 0 {
+		m["filterPolicyScope"] = value.FilterPolicyScope
+	}
+	if value.RawMessageDelivery != nil {
+		m["rawMessageDelivery"] = value.RawMessageDelivery
+	}
+	if value.RedrivePolicy != nil {
+		m["redrivePolicy"] = value.RedrivePolicy
+	}
+	if value.DeliveryPolicy != nil {
+		m["deliveryPolicy"] = value.DeliveryPolicy
+	}
+	if len(value.DisplayName) != 0 {
+		m["displayName"] = value.DisplayName
+	}
+
+	return json.Marshal(m)
+}
+
+func (value *SnsConsumer) UnmarshalJSON(data []byte) error {
+	type SnsConsumerBis SnsConsumer
+	var x SnsConsumerBis
+	if err := json.Unmarshal(data, &x); err != nil {
+		return err
+	}
+	_ = json.Unmarshal(data, &x.Extensions)
+
+	delete(x.Extensions, "protocol")
+	delete(x.Extensions, "endpoint")
+	delete(x.Extensions, "filterPolicy")
+	delete(x.Extensions, "filterPolicyScope")
+	delete(x.Extensions, "rawMessageDelivery")
+	delete(x.Extensions, "redrivePolicy")
+	delete(x.Extensions, "deliveryPolicy")
+	delete(x.Extensions, "displayName")
+
+	*value = SnsConsumer(x)
+
+	return nil
+}
+
+func (value *SnsConsumer) Validate(ctx context.Context) error {
+	if err := validate.Extensions(ctx, value.Extensions); err != nil {
+		return err
+	}
+
+	if _, ok := snsProtocols[value.Protocol]; !ok {
+		rule, err := validate.RuleValue, fmt.Errorf("protocol %q is not supported by SNS: %w", value.Protocol, validate.ErrWrongField)
+		if value.Protocol == "" {
+			rule, err = validate.RuleRequired, fmt.Errorf("value of protocol must be a non-empty string: %w", validate.ErrWrongField)
+		}
+
+		if err := validate.Report(validate.At(ctx, "protocol"), rule, err); err != nil {
+			return err
+		}
+	}
+
+	if v := value.Endpoint; v != nil {
+		if err := v.Validate(validate.At(ctx, "endpoint")); err != nil {
+			return err
+		}
+	} else {
+		err := fmt.Errorf("field endpoint is required: %w", validate.ErrWrongField)
+		if err := validate.Report(validate.At(ctx, "endpoint"), validate.RuleRequired, err); err != nil {
+			return err
+		}
+	}
+
+	switch value.FilterPolicyScope {
+	case "", SnsFilterPolicyScopeMessageAttributes, SnsFilterPolicyScopeMessageBody:
+	default:
+		err := fmt.Errorf(
+			"filterPolicyScope must be either MessageAttributes or MessageBody: %w",
+			validate.ErrWrongField,
+		)
+		if err := validate.Report(validate.At(ctx, "filterPolicyScope"), validate.RuleValue, err); err != nil {
+			return err
+		}
+	}
+
+	if value.RawMessageDelivery == nil {
+		err := fmt.Errorf("field rawMessageDelivery is required: %w", validate.ErrWrongField)
+		if err := validate.Report(validate.At(ctx, "rawMessageDelivery"), validate.RuleRequired, err); err != nil {
+			return err
+		}
+	}
+
+	if v := value.RedrivePolicy; v != nil {
+		if err := v.Validate(validate.At(ctx, "redrivePolicy")); err != nil {
+			return err
+		}
+	}
+
+	if v := value.DeliveryPolicy; v != nil {
+		if err := v.Validate(validate.At(ctx, "deliveryPolicy")); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SnsRedrivePolicy describes where undeliverable messages go.
+type SnsRedrivePolicy struct {
+	Extensions map[string]interface{} `json:"-" yaml:"-"`
+
+	DeadLetterQueue *SnsIdentifier `json:"deadLetterQueue,omitempty" yaml:"deadLetterQueue,omitempty"`
+	MaxReceiveCount *int           `json:"maxReceiveCount,omitempty" yaml:"maxReceiveCount,omitempty"`
+}
+
+func (value *SnsRedrivePolicy) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{}, 2+len(value.Extensions))
+	for k, v := range value.Extensions {
+		m[k] = v
+	}
+
+	if value.DeadLetterQueue != nil {
+		m["deadLetterQueue"] = value.DeadLetterQueue
+	}
+	if value.MaxReceiveCount != nil {
+		m["maxReceiveCount"] = value.MaxReceiveCount
+	}
+
+	return json.Marshal(m)
+}
+
+func (value *SnsRedrivePolicy) UnmarshalJSON(data []byte) error {
+	type SnsRedrivePolicyBis SnsRedrivePolicy
+	var x SnsRedrivePolicyBis
+	if err := json.Unmarshal(data, &x); err != nil {
+		return err
+	}
+	_ = json.Unmarshal(data, &x.Extensions)
+
+	delete(x.Extensions, "deadLetterQueue")
+	delete(x.Extensions, "maxReceiveCount")
+
+	*value = SnsRedrivePolicy(x)
+
+	return nil
+}
+
+func (value *SnsRedrivePolicy) Validate(ctx context.Context) error {
+	if err := validate.Extensions(ctx, value.Extensions); err != nil {
+		return err
+	}
+
+	if v := value.DeadLetterQueue; v != nil {
+		if err := v.Validate(validate.At(ctx, "deadLetterQueue")); err != nil {
+			return err
+		}
+	} else {
+		err := fmt.Errorf("field deadLetterQueue is required: %w", validate.ErrWrongField)
+		if err := validate.Report(validate.At(ctx, "deadLetterQueue"), validate.RuleRequired, err); err != nil {
+			return err
+		}
+	}
+
+	return validateRange(validate.At(ctx, "maxReceiveCount"), value.MaxReceiveCount, 1, math.MaxInt32)
+}
+
+// SnsDeliveryPolicy describes retries of deliveries over HTTP.
+type SnsDeliveryPolicy struct {
+	Extensions map[string]interface{} `json:"-" yaml:"-"`
+
+	MinDelayTarget       *int               `json:"minDelayTarget,omitempty" yaml:"minDelayTarget,omitempty"`
+	MaxDelayTarget       *int               `json:"maxDelayTarget,omitempty" yaml:"maxDelayTarget,omitempty"`
+	NumRetries           *int               `json:"numRetries,omitempty" yaml:"numRetries,omitempty"`
+	NumNoDelayRetries    *int               `json:"numNoDelayRetries,omitempty" yaml:"numNoDelayRetries,omitempty"`
+	NumMinDelayRetries   *int               `json:"numMinDelayRetries,omitempty" yaml:"numMinDelayRetries,omitempty"`
+	NumMaxDelayRetries   *int               `json:"numMaxDelayRetries,omitempty" yaml:"numMaxDelayRetries,omitempty"`
+	BackoffFunction      SnsBackoffFunction `json:"backoffFunction,omitempty" yaml:"backoffFunction,omitempty"`
+	MaxReceivesPerSecond *int               `json:"maxReceivesPerSecond,omitempty" yaml:"maxReceivesPerSecond,omitempty"`
+}
+
+func (value *SnsDeliveryPolicy) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{}, 8+len(value.Extensions))
+	for k, v := range value.Extensions {
+		m[k] = v
+	}
+
+	if value.MinDelayTarget != nil {
+		m["minDelayTarget"] = value.MinDelayTarget
+	}
+	if value.MaxDelayTarget != nil {
+		m["maxDelayTarget"] = value.MaxDelayTarget
+	}
+	if value.NumRetries != nil {
+		m["numRetries"] = value.NumRetries
+	}
+	if value.NumNoDelayRetries != nil {
+		m["numNoDelayRetries"] = value.NumNoDelayRetries
+	}
+	if value.NumMinDelayRetries != nil {
+		m["numMinDelayRetries"] = value.NumMinDelayRetries
+	}
+	if value.NumMaxDelayRetries != nil {
+		m["numMaxDelayRetries"] = value.NumMaxDelayRetries
+	}
+	if len(value.BackoffFunction) != 0 {
+		m["backoffFunction"] = value.BackoffFunction
+	}
+	if value.MaxReceivesPerSecond != nil {
+		m["maxReceivesPerSecond"] = value.MaxReceivesPerSecond
+	}
+
+	return json.Marshal(m)
+}
+
+func (value *SnsDeliveryPolicy) UnmarshalJSON(data []byte) error {
+	type SnsDeliveryPolicyBis SnsDeliveryPolicy
+	var x SnsDeliveryPolicyBis
+	if err := json.Unmarshal(data, &x); err != nil {
+		return err
+	}
+	_ = json.Unmarshal(data, &x.Extensions)
+
+	delete(x.Extensions, "minDelayTarget")
+	delete(x.Extensions, "maxDelayTarget")
+	delete(x.Extensions, "numRetries")
+	delete(x.Extensions, "numNoDelayRetries")
+	delete(x.Extensions, "numMinDelayRetries")
+	delete(x.Extensions, "numMaxDelayRetries")
+	delete(x.Extensions, "backoffFunction")
+	delete(x.Extensions, "maxReceivesPerSecond")
+
+	*value = SnsDeliveryPolicy(x)
+
+	return nil
+}
+
+func (value *SnsDeliveryPolicy) Validate(ctx context.Context) error {
+	if err := validate.Extensions(ctx, value.Extensions); err != nil {
+		return err
+	}
+
+	limits := []struct {
+		field string
+		value *int
+	}{
+		{"minDelayTarget", value.MinDelayTarget},
+		{"maxDelayTarget", value.MaxDelayTarget},
+		{"numRetries", value.NumRetries},
+		{"numNoDelayRetries", value.NumNoDelayRetries},
+		{"numMinDelayRetries", value.NumMinDelayRetries},
+		{"numMaxDelayRetries", value.NumMaxDelayRetries},
+		{"maxReceivesPerSecond", value.MaxReceivesPerSecond},
+	}
+
+	for _, v := range limits {
+		if err := validateRange(validate.At(ctx, v.field), v.value, 0, math.MaxInt32); err != nil {
+			return err
+		}
+	}
+
+	if minDelay, maxDelay := value.MinDelayTarget, value.MaxDelayTarget; minDelay != nil && maxDelay != nil && *minDelay > *maxDelay {
+		err := fmt.Errorf("minDelayTarget must not be greater than maxDelayTarget: %w", validate.ErrWrongField)
+		if err := validate.Report(validate.At(ctx, "minDelayTarget"), validate.RuleValue, err); err != nil {
+			return err
+		}
+	}
+
+	switch value.BackoffFunction {
+	case "", SnsBackoffArithmetic, SnsBackoffExponential, SnsBackoffGeometric, SnsBackoffLinear:
+	default:
+		err := fmt.Errorf(
+			"backoffFunction must be one of arithmetic, exponential, geometric or linear: %w",
+			validate.ErrWrongField,
+		)
+		if err := validate.Report(validate.At(ctx, "backoffFunction"), validate.RuleValue, err); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SnsMessage is defined in AsyncAPI spec: https://github.com/asyncapi/bindings/tree/master/sns#message-binding-object
+type SnsMessage struct {
+	Extensions map[string]interface{} `json:"-" yaml:"-"`
+
+	BindingVersion string `json:"bindingVersion,omitempty" yaml:"bindingVersion,omitempty"`
+}
+
+func (binding *SnsMessage) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{}, 1+len(binding.Extensions))
+	for k, v := range binding.Extensions {
+		m[k] = v
+	}
+
+	if len(binding.BindingVersion) != 0 {
+		m["bindingVersion"] = binding.BindingVersion
+	}
+
+	return json.Marshal(m)
+}
+
+func (binding *SnsMessage) UnmarshalJSON(data []byte) error {
+	type SnsMessageBis SnsMessage
+	var x SnsMessageBis
+	if err := json.Unmarshal(data, &x); err != nil {
+		return err
+	}
+	_ = json.Unmarshal(data, &x.Extensions)
+
+	delete(x.Extensions, "bindingVersion")
+
+	*binding = SnsMessage(x)
+
+	return nil
+}
+
+func (binding *SnsMessage) Validate(ctx context.Context) error {
+	if err := validate.Extensions(ctx, binding.Extensions); err != nil {
+		return err
+	}
+
+	if err := validateBindingVersion(ctx, "sns", binding.BindingVersion); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,124 @@
+package bindings
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/rdmrcv/go-asyncapi2/spec/validate"
+)
+
+func TestSnsOperation_UnmarshalJSON(t *testing.T) {
+	data := []byte(`{
+		"consumers": [{
+			"protocol": "sqs",
+			"endpoint": {"name": "user-signedup-queue"},
+			"filterPolicy": {"store": ["asyncapi_revenue"]},
+			"rawMessageDelivery": false,
+			"redrivePolicy": {"deadLetterQueue": {"arn": "arn:aws:SQS:eu-west-1:0000000:123456789"}, "maxReceiveCount": 25},
+			"deliveryPolicy": {"minDelayTarget": 10, "maxDelayTarget": 100, "backoffFunction": "linear"}
+		}],
+		"bindingVersion": "0.1.0"
+	}`)
+
+	var binding SnsOperation
+	if err := json.Unmarshal(data, &binding); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(binding.Consumers) != 1 {
+		t.Fatalf("expected one consumer, got %d", len(binding.Consumers))
+	}
+
+	consumer := binding.Consumers[0]
+	if consumer.Protocol != SnsProtocolSqs || consumer.Endpoint.Name != "user-signedup-queue" ||
+		consumer.RawMessageDelivery == nil || *consumer.RedrivePolicy.MaxReceiveCount != 25 {
+		t.Errorf("unexpected consumer %+v", consumer)
+	}
+
+	if err := validate.Collect(context.Background(), binding.Validate); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSnsSqsBindings_Validate(t *testing.T) {
+	count := func(v int) *int { return &v }
+	fifo := false
+
+	tests := []struct {
+		name     string
+		binding  interface{ Validate(context.Context) error }
+		pointers []string
+	}{
+		{
+			name:     "sqs message binding version",
+			binding:  &SqsMessage{BindingVersion: "0.3.0"},
+			pointers: []string{"/bindingVersion"},
+		},
+		{
+			name: "invalid sns channel",
+			binding: &SnsChannel{
+				Ordering: &SnsOrdering{Type: "fifo"},
+				Policy:   &AwsPolicy{Statements: []*AwsPolicyStatement{{Effect: "Allow", Principal: "*", Action: 1}}},
+			},
+			pointers: []string{"/name", "/ordering/type", "/policy/statements/0/action"},
+		},
+		{
+			name: "invalid sns consumer",
+			binding: &SnsOperation{Consumers: []*SnsConsumer{{
+				Protocol:       "ftp",
+				Endpoint:       &SnsIdentifier{},
+				DeliveryPolicy: &SnsDeliveryPolicy{MinDelayTarget: count(20), MaxDelayTarget: count(10)},
+			}}},
+			pointers: []string{
+				"/consumers/0/protocol",
+				"/consumers/0/endpoint",
+				"/consumers/0/rawMessageDelivery",
+				"/consumers/0/deliveryPolicy/minDelayTarget",
+			},
+		},
+		{
+			name: "sqs ranges",
+			binding: &SqsChannel{Queue: &SqsQueue{
+				Name:                   "my-queue",
+				FifoQueue:              &fifo,
+				VisibilityTimeout:      count(43201),
+				ReceiveMessageWaitTime: count(20),
+				MessageRetentionPeriod: count(59),
+				RedrivePolicy:          &SqsRedrivePolicy{DeadLetterQueue: &SqsIdentifier{Name: "dlq"}, MaxReceiveCount: count(0)},
+			}},
+			pointers: []string{
+				"/queue/visibilityTimeout",
+				"/queue/messageRetentionPeriod",
+				"/queue/redrivePolicy/maxReceiveCount",
+			},
+		},
+		{
+			name:     "sqs operation",
+			binding:  &SqsOperation{Queues: []*SqsQueue{{FifoQueue: &fifo}}},
+			pointers: []string{"/queues/0/name"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validate.Collect(context.Background(), tt.binding.Validate)
+
+			var errs validate.Errors
+			if err != nil && !errors.As(err, &errs) {
+				t.Fatalf("expected validate.Errors, got %v", err)
+			}
+
+			if len(errs) != len(tt.pointers) {
+				t.Fatalf("expected errors at %v, got %v", tt.pointers, err)
+			}
+
+			for i, v := range tt.pointers {
+				if errs[i].Pointer != v {
+					t.Errorf("expected error at %s, got %s", v, errs[i].Pointer)
+				}
+			}
+		})
+	}
+}
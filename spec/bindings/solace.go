@@ -0,0 +1,394 @@
+package bindings
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/rdmrcv/go-asyncapi2/spec/validate"
+)
+
+const solaceClientNameMaxLength = 160
+
+type SolaceDestinationType string
+
+const (
+	SolaceDestinationQueue SolaceDestinationType = "queue"
+	SolaceDestinationTopic SolaceDestinationType = "topic"
+)
+
+type SolaceDeliveryMode string
+
+const (
+	SolaceDeliveryModeDirect     SolaceDeliveryMode = "direct"
+	SolaceDeliveryModePersistent SolaceDeliveryMode = "persistent"
+)
+
+type SolaceAccessType string
+
+const (
+	SolaceAccessExclusive    SolaceAccessType = "exclusive"
+	SolaceAccessNonExclusive SolaceAccessType = "nonexclusive"
+)
+
+// SolaceServer is defined in AsyncAPI spec: https://github.com/asyncapi/bindings/tree/master/solace#server-binding-object
+type SolaceServer struct {
+	Extensions map[string]interface{} `json:"-" yaml:"-"`
+
+	MsgVpn         string `json:"msgVpn,omitempty" yaml:"msgVpn,omitempty"`
+	ClientName     string `json:"clientName,omitempty" yaml:"clientName,omitempty"`
+	BindingVersion string `json:"bindingVersion,omitempty" yaml:"bindingVersion,omitempty"`
+}
+
+func (binding *SolaceServer) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{}, 3+len(binding.Extensions))
+	for k, v := range binding.Extensions {
+		m[k] = v
+	}
+
+	if len(binding.MsgVpn) != 0 {
+		m["msgVpn"] = binding.MsgVpn
+	}
+	if len(binding.ClientName) != 0 {
+		m["clientName"] = binding.ClientName
+	}
+	if len(binding.BindingVersion) != 0 {
+		m["bindingVersion"] = binding.BindingVersion
+	}
+
+	return json.Marshal(m)
+}
+
+func (binding *SolaceServer) UnmarshalJSON(data []byte) error {
+	type SolaceServerBis SolaceServer
+	var x SolaceServerBis
+	if err := json.Unmarshal(data, &x); err != nil {
+		return err
+	}
+	_ = json.Unmarshal(data, &x.Extensions)
+
+	delete(x.Extensions, "msgVpn")
+	delete(x.Extensions, "clientName")
+	delete(x.Extensions, "bindingVersion")
+
+	*binding = SolaceServer(x)
+
+	return nil
+}
+
+func (binding *SolaceServer) Validate(ctx context.Context) error {
+	if err := validate.Extensions(ctx, binding.Extensions); err != nil {
+		return err
+	}
+
+	err := validateVersionedFields(ctx, "solace", binding.BindingVersion, []versionedField{
+		{name: "clientName", since: "0.4.0", set: len(binding.ClientName) != 0},
+	})
+	if err != nil {
+		return err
+	}
+
+	return validateMaxLength(validate.At(ctx, "clientName"), binding.ClientName, solaceClientNameMaxLength)
+}
+
+// SolaceOperation is defined in AsyncAPI spec: https://github.com/asyncapi/bindings/tree/master/solace#operation-binding-object
+type SolaceOperation struct {
+	Extensions map[string]interface{} `json:"-" yaml:"-"`
+
+	Destinations   []*SolaceDestination `json:"destinations,omitempty" yaml:"destinations,omitempty"`
+	Priority       *int                 `json:"priority,omitempty" yaml:"priority,omitempty"`
+	TimeToLive     *int                 `json:"timeToLive,omitempty" yaml:"timeToLive,omitempty"`
+	DmqEligible    *bool                `json:"dmqEligible,omitempty" yaml:"dmqEligible,omitempty"`
+	BindingVersion string               `json:"bindingVersion,omitempty" yaml:"bindingVersion,omitempty"`
+}
+
+func (binding *SolaceOperation) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{}, 5+len(binding.Extensions))
+	for k, v := range binding.Extensions {
+		m[k] = v
+	}
+
+	if len(binding.Destinations) != 0 {
+		m["destinations"] = binding.Destinations
+	}
+	if binding.Priority != nil {
+		m["priority"] = binding.Priority
+	}
+	if binding.TimeToLive != nil {
+		m["timeToLive"] = binding.TimeToLive
+	}
+	if binding.DmqEligible != nil {
+		m["dmqEligible"] = binding.DmqEligible
+	}
+	if len(binding.BindingVersion) != 0 {
+		m["bindingVersion"] = binding.BindingVersion
+	}
+
+	return json.Marshal(m)
+}
+
+func (binding *SolaceOperation) UnmarshalJSON(data []byte) error {
+	type SolaceOperationBis SolaceOperation
+	var x SolaceOperationBis
+	if err := json.Unmarshal(data, &x); err != nil {
+		return err
+	}
+	_ = json.Unmarshal(data, &x.Extensions)
+
+	delete(x.Extensions, "destinations")
+	delete(x.Extensions, "priority")
+	delete(x.Extensions, "timeToLive")
+	delete(x.Extensions, "dmqEligible")
+	delete(x.Extensions, "bindingVersion")
+
+	*binding = SolaceOperation(x)
+
+	return nil
+}
+
+func (binding *SolaceOperation) Validate(ctx context.Context) error {
+	if err := validate.Extensions(ctx, binding.Extensions); err != nil {
+		return err
+	}
+
+	fields := []versionedField{
+		{name: "priority", since: "0.4.0", set: binding.Priority != nil},
+		{name: "timeToLive", since: "0.4.0", set: binding.TimeToLive != nil},
+		{name: "dmqEligible", since: "0.4.0", set: binding.DmqEligible != nil},
+	}
+	for i, v := range binding.Destinations {
+		if v == nil || v.Queue == nil {
+			continue
+		}
+
+		queue := "destinations/" + strconv.Itoa(i) + "/queue/"
+		fields = append(fields,
+			versionedField{name: queue + "maxMsgSpoolSize", since: "0.3.0", set: len(v.Queue.MaxMsgSpoolSize) != 0},
+			versionedField{name: queue + "maxTtl", since: "0.3.0", set: len(v.Queue.MaxTTL) != 0},
+		)
+	}
+
+	if err := validateVersionedFields(ctx, "solace", binding.BindingVersion, fields); err != nil {
+		return err
+	}
+
+	for i, v := range binding.Destinations {
+		if v == nil {
+			continue
+		}
+
+		if err := v.Validate(validate.At(ctx, "destinations", strconv.Itoa(i))); err != nil {
+			return err
+		}
+	}
+
+	if err := validateRange(validate.At(ctx, "priority"), binding.Priority, 0, 255); err != nil {
+		return err
+	}
+
+	return validateRange(validate.At(ctx, "timeToLive"), binding.TimeToLive, 0, math.MaxInt32)
+}
+
+// SolaceDestination is a queue or a topic the operation publishes to or consumes from.
+type SolaceDestination struct {
+	Extensions map[string]interface{} `json:"-" yaml:"-"`
+
+	DestinationType SolaceDestinationType `json:"destinationType,omitempty" yaml:"destinationType,omitempty"`
+	DeliveryMode    SolaceDeliveryMode    `json:"deliveryMode,omitempty" yaml:"deliveryMode,omitempty"`
+	Queue           *SolaceQueue          `json:"queue,omitempty" yaml:"queue,omitempty"`
+	Topic           *SolaceTopic          `json:"topic,omitempty" yaml:"topic,omitempty"`
+}
+
+func (value *SolaceDestination) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{}, 4+len(value.Extensions))
+	for k, v := range value.Extensions {
+		m[k] = v
+	}
+
+	if len(value.DestinationType) != 0 {
+		m["destinationType"] = value.DestinationType
+	}
+	if len(value.DeliveryMode) != 0 {
+		m["deliveryMode"] = value.DeliveryMode
+	}
+	if value.Queue != nil {
+		m["queue"] = value.Queue
+	}
+	if value.Topic != nil {
+		m["topic"] = value.Topic
+	}
+
+	return json.Marshal(m)
+}
+
+func (value *SolaceDestination) UnmarshalJSON(data []byte) error {
+	type SolaceDestinationBis SolaceDestination
+	var x SolaceDestinationBis
+	if err := json.Unmarshal(data, &x); err != nil {
+		return err
+	}
+	_ = json.Unmarshal(data, &x.Extensions)
+
+	delete(x.Extensions, "destinationType")
+	delete(x.Extensions, "deliveryMode")
+	delete(x.Extensions, "queue")
+	delete(x.Extensions, "topic")
+
+	*value = SolaceDestination(x)
+
+	return nil
+}
+
+func (value *SolaceDestination) Validate(ctx context.Context) error {
+	if err := validate.Extensions(ctx, value.Extensions); err != nil {
+		return err
+	}
+
+	switch value.DestinationType {
+	case SolaceDestinationQueue:
+		if value.Queue == nil {
+			err := fmt.Errorf("field queue is required when destinationType is queue: %w", validate.ErrWrongField)
+			if err := validate.Report(validate.At(ctx, "queue"), validate.RuleRequired, err); err != nil {
+				return err
+			}
+		}
+	case SolaceDestinationTopic:
+		if value.Queue != nil {
+			err := fmt.Errorf("queue must not be set when destinationType is topic: %w", validate.ErrWrongField)
+			if err := validate.Report(validate.At(ctx, "queue"), validate.RuleValue, err); err != nil {
+				return err
+			}
+		}
+	default:
+		err := fmt.Errorf("destinationType must be either queue or topic: %w", validate.ErrWrongField)
+		if err := validate.Report(validate.At(ctx, "destinationType"), validate.RuleValue, err); err != nil {
+			return err
+		}
+	}
+
+	switch value.DeliveryMode {
+	case "", SolaceDeliveryModeDirect, SolaceDeliveryModePersistent:
+	default:
+		err := fmt.Errorf("deliveryMode must be either direct or persistent: %w", validate.ErrWrongField)
+		if err := validate.Report(validate.At(ctx, "deliveryMode"), validate.RuleValue, err); err != nil {
+			return err
+		}
+	}
+
+	if v := value.Queue; v != nil {
+		ctx := validate.At(ctx, "queue")
+
+		if err := validate.Extensions(ctx, v.Extensions); err != nil {
+			return err
+		}
+
+		switch v.AccessType {
+		case "", SolaceAccessExclusive, SolaceAccessNonExclusive:
+		default:
+			err := fmt.Errorf("accessType must be either exclusive or nonexclusive: %w", validate.ErrWrongField)
+			if err := validate.Report(validate.At(ctx, "accessType"), validate.RuleValue, err); err != nil {
+				return err
+			}
+		}
+	}
+
+	if v := value.Topic; v != nil {
+		if err := validate.Extensions(validate.At(ctx, "topic"), v.Extensions); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SolaceQueue describes the queue of the destination.
+type SolaceQueue struct {
+	Extensions map[string]interface{} `json:"-" yaml:"-"`
+
+	Name               string           `json:"name,omitempty" yaml:"name,omitempty"`
+	TopicSubscriptions []string         `json:"topicSubscriptions,omitempty" yaml:"topicSubscriptions,omitempty"`
+	AccessType         SolaceAccessType `json:"accessType,omitempty" yaml:"accessType,omitempty"`
+	MaxMsgSpoolSize    string           `json:"maxMsgSpoolSize,omitempty" yaml:"maxMsgSpoolSize,omitempty"`
+	MaxTTL             string           `json:"maxTtl,omitempty" yaml:"maxTtl,omitempty"`
+}
+
+func (value *SolaceQueue) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{}, 5+len(value.Extensions))
+	for k, v := range value.Extensions {
+		m[k] = v
+	}
+
+	if len(value.Name) != 0 {
+		m["name"] = value.Name
+	}
+	if len(value.TopicSubscriptions) != 0 {
+		m["topicSubscriptions"] = value.TopicSubscriptions
+	}
+	if len(value.AccessType) != 0 {
+		m["accessType"] = value.AccessType
+	}
+	if len(value.MaxMsgSpoolSize) != 0 {
+		m["maxMsgSpoolSize"] = value.MaxMsgSpoolSize
+	}
+	if len(value.MaxTTL) != 0 {
+		m["maxTtl"] = value.MaxTTL
+	}
+
+	return json.Marshal(m)
+}
+
+func (value *SolaceQueue) UnmarshalJSON(data []byte) error {
+	type SolaceQueueBis SolaceQueue
+	var x SolaceQueueBis
+	if err := json.Unmarshal(data, &x); err != nil {
+		return err
+	}
+	_ = json.Unmarshal(data, &x.Extensions)
+
+	delete(x.Extensions, "name")
+	delete(x.Extensions, "topicSubscriptions")
+	delete(x.Extensions, "accessType")
+	delete(x.Extensions, "maxMsgSpoolSize")
+	delete(x.Extensions, "maxTtl")
+
+	*value = SolaceQueue(x)
+
+	return nil
+}
+
+// SolaceTopic describes the topic of the destination.
+type SolaceTopic struct {
+	Extensions map[string]interface{} `json:"-" yaml:"-"`
+
+	TopicSubscriptions []string `json:"topicSubscriptions,omitempty" yaml:"topicSubscriptions,omitempty"`
+}
+
+func (value *SolaceTopic) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{}, 1+len(value.Extensions))
+	for k, v := range value.Extensions {
+		m[k] = v
+	}
+
+	if len(value.TopicSubscriptions) != 0 {
+		m["topicSubscriptions"] = value.TopicSubscriptions
+	}
+
+	return json.Marshal(m)
+}
+
+func (value *SolaceTopic) UnmarshalJSON(data []byte) error {
+	type SolaceTopicBis SolaceTopic
+	var x SolaceTopicBis
+	if err := json.Unmarshal(data, &x); err != nil {
+		return err
+	}
+	_ = json.Unmarshal(data, &x.Extensions)
+
+	delete(x.Extensions, "topicSubscriptions")
+
+	*value = SolaceTopic(x)
+
+	return nil
+}
@@ -0,0 +1,119 @@
+package bindings
+
+import (
+	"context"
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestSolaceBindings_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		binding interface{}
+	}{
+		{
+			name:    "server",
+			data:    `{"msgVpn": "orders", "bindingVersion": "0.3.0", "x-region": "eu"}`,
+			binding: &SolaceServer{},
+		},
+		{
+			name: "operation",
+			data: `{
+				"destinations": [
+					{"destinationType": "queue", "deliveryMode": "persistent", "queue": {"name": "orders", "topicSubscriptions": ["orders/>"], "accessType": "exclusive", "x-owner": "sales"}, "x-note": "primary"},
+					{"destinationType": "topic", "topic": {"topicSubscriptions": ["orders/created"], "x-owner": "sales"}}
+				],
+				"bindingVersion": "0.2.0",
+				"x-region": "eu"
+			}`,
+			binding: &SolaceOperation{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checkRoundTrip(t, []byte(tt.data), tt.binding)
+		})
+	}
+}
+
+func TestSolaceBindings_Validate(t *testing.T) {
+	number := func(v int) *int { return &v }
+
+	tests := []struct {
+		name     string
+		binding  interface{ Validate(context.Context) error }
+		pointers []string
+	}{
+		{
+			name:     "long server clientName",
+			binding:  &SolaceServer{ClientName: strings.Repeat("c", solaceClientNameMaxLength+1)},
+			pointers: []string{"/clientName"},
+		},
+		{
+			name: "operation",
+			binding: &SolaceOperation{
+				Destinations: []*SolaceDestination{
+					{
+						DestinationType: SolaceDestinationQueue,
+						DeliveryMode:    SolaceDeliveryModeDirect,
+						Queue:           &SolaceQueue{Name: "orders", AccessType: SolaceAccessNonExclusive},
+					},
+					{DestinationType: SolaceDestinationTopic, Topic: &SolaceTopic{TopicSubscriptions: []string{"orders/>"}}},
+				},
+				Priority:   number(255),
+				TimeToLive: number(math.MaxInt32),
+			},
+		},
+		{
+			name:     "destination without destinationType",
+			binding:  &SolaceOperation{Destinations: []*SolaceDestination{{}}},
+			pointers: []string{"/destinations/0/destinationType"},
+		},
+		{
+			name:     "unknown destinationType",
+			binding:  &SolaceOperation{Destinations: []*SolaceDestination{{DestinationType: "stream"}}},
+			pointers: []string{"/destinations/0/destinationType"},
+		},
+		{
+			name:     "queue destination without queue",
+			binding:  &SolaceOperation{Destinations: []*SolaceDestination{{DestinationType: SolaceDestinationQueue}}},
+			pointers: []string{"/destinations/0/queue"},
+		},
+		{
+			name: "topic destination with queue",
+			binding: &SolaceOperation{Destinations: []*SolaceDestination{
+				{DestinationType: SolaceDestinationTopic},
+				{DestinationType: SolaceDestinationTopic, Queue: &SolaceQueue{Name: "orders"}},
+			}},
+			pointers: []string{"/destinations/1/queue"},
+		},
+		{
+			name: "unknown deliveryMode and accessType",
+			binding: &SolaceOperation{Destinations: []*SolaceDestination{{
+				DestinationType: SolaceDestinationQueue,
+				DeliveryMode:    "guaranteed",
+				Queue:           &SolaceQueue{Name: "orders", AccessType: "shared"},
+			}}},
+			pointers: []string{"/destinations/0/deliveryMode", "/destinations/0/queue/accessType"},
+		},
+		{
+			name:     "priority out of range",
+			binding:  &SolaceOperation{Priority: number(256)},
+			pointers: []string{"/priority"},
+		},
+		{
+			name:     "negative timeToLive",
+			binding:  &SolaceOperation{TimeToLive: number(-1)},
+			pointers: []string{"/timeToLive"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checkValidate(t, tt.binding, tt.pointers)
+		})
+	}
+}
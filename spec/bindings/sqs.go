@@ -0,0 +1,552 @@
+package bindings
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/rdmrcv/go-asyncapi2/spec/validate"
+)
+
+// sqsQueuesAdded is the binding version channels and operations describe queues since.
+const sqsQueuesAdded = "0.2.0"
+
+type SqsDeduplicationScope string
+
+const (
+	SqsDeduplicationScopeQueue        SqsDeduplicationScope = "queue"
+	SqsDeduplicationScopeMessageGroup SqsDeduplicationScope = "messageGroup"
+)
+
+type SqsFifoThroughputLimit string
+
+const (
+	SqsFifoThroughputLimitPerQueue          SqsFifoThroughputLimit = "perQueue"
+	SqsFifoThroughputLimitPerMessageGroupID SqsFifoThroughputLimit = "perMessageGroupId"
+)
+
+// SqsServer is defined in AsyncAPI spec: https://github.com/asyncapi/bindings/tree/master/sqs#server-binding-object
+type SqsServer struct {
+	Extensions map[string]interface{} `json:"-" yaml:"-"`
+
+	BindingVersion string `json:"bindingVersion,omitempty" yaml:"bindingVersion,omitempty"`
+}
+
+func (binding *SqsServer) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{}, 1+len(binding.Extensions))
+	for k, v := range binding.Extensions {
+		m[k] = v
+	}
+
+	if len(binding.BindingVersion) != 0 {
+		m["bindingVersion"] = binding.BindingVersion
+	}
+
+	return json.Marshal(m)
+}
+
+func (binding *SqsServer) UnmarshalJSON(data []byte) error {
+	type SqsServerBis SqsServer
+	var x SqsServerBis
+	if err := json.Unmarshal(data, &x); err != nil {
+		return err
+	}
+	_ = json.Unmarshal(data, &x.Extensions)
+
+	delete(x.Extensions, "bindingVersion")
+
+	*binding = SqsServer(x)
+
+	return nil
+}
+
+func (binding *SqsServer) Validate(ctx context.Context) error {
+	if err := validate.Extensions(ctx, binding.Extensions); err != nil {
+		return err
+	}
+
+	if err := validateBindingVersion(ctx, "sqs", binding.BindingVersion); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SqsChannel is defined in AsyncAPI spec: https://github.com/asyncapi/bindings/tree/master/sqs#channel-binding-object
+type SqsChannel struct {
+	Extensions map[string]interface{} `json:"-" yaml:"-"`
+
+	Queue           *SqsQueue `json:"queue,omitempty" yaml:"queue,omitempty"`
+	DeadLetterQueue *SqsQueue `json:"deadLetterQueue,omitempty" yaml:"deadLetterQueue,omitempty"`
+	BindingVersion  string    `json:"bindingVersion,omitempty" yaml:"bindingVersion,omitempty"`
+}
+
+func (binding *SqsChannel) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{}, 3+len(binding.Extensions))
+	for k, v := range binding.Extensions {
+		m[k] = v
+	}
+
+	if binding.Queue != nil {
+		m["queue"] = binding.Queue
+	}
+	if binding.DeadLetterQueue != nil {
+		m["deadLetterQueue"] = binding.DeadLetterQueue
+	}
+	if len(binding.BindingVersion) != 0 {
+		m["bindingVersion"] = binding.BindingVersion
+	}
+
+	return json.Marshal(m)
+}
+
+func (binding *SqsChannel) UnmarshalJSON(data []byte) error {
+	type SqsChannelBis SqsChannel
+	var x SqsChannelBis
+	if err := json.Unmarshal(data, &x); err != nil {
+		return err
+	}
+	_ = json.Unmarshal(data, &x.Extensions)
+
+	delete(x.Extensions, "queue")
+	delete(x.Extensions, "deadLetterQueue")
+	delete(x.Extensions, "bindingVersion")
+
+	*binding = SqsChannel(x)
+
+	return nil
+}
+
+func (binding *SqsChannel) Validate(ctx context.Context) error {
+	if err := validate.Extensions(ctx, binding.Extensions); err != nil {
+		return err
+	}
+
+	err := validateVersionedFields(ctx, "sqs", binding.BindingVersion, []versionedField{
+		{name: "queue", since: sqsQueuesAdded, set: binding.Queue != nil},
+		{name: "deadLetterQueue", since: sqsQueuesAdded, set: binding.DeadLetterQueue != nil},
+	})
+	if err != nil {
+		return err
+	}
+
+	// Channels have no fields before 0.2.0.
+	if compareBindingVersions(effectiveBindingVersion("sqs", binding.BindingVersion), sqsQueuesAdded) < 0 {
+		return nil
+	}
+
+	if v := binding.Queue; v != nil {
+		if err := v.Validate(validate.At(ctx, "queue")); err != nil {
+			return err
+		}
+	} else {
+		err := fmt.Errorf("field queue is required: %w", validate.ErrWrongField)
+		if err := validate.Report(validate.At(ctx, "queue"), validate.RuleRequired, err); err != nil {
+			return err
+		}
+	}
+
+	if v := binding.DeadLetterQueue; v != nil {
+		if err := v.Validate(validate.At(ctx, "deadLetterQueue")); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SqsQueue describes a queue.
+type SqsQueue struct {
+	Extensions map[string]interface{} `json:"-" yaml:"-"`
+
+	Name                   string                 `json:"name,omitempty" yaml:"name,omitempty"`
+	FifoQueue              *bool                  `json:"fifoQueue,omitempty" yaml:"fifoQueue,omitempty"`
+	DeduplicationScope     SqsDeduplicationScope  `json:"deduplicationScope,omitempty" yaml:"deduplicationScope,omitempty"`
+	FifoThroughputLimit    SqsFifoThroughputLimit `json:"fifoThroughputLimit,omitempty" yaml:"fifoThroughputLimit,omitempty"`
+	DeliveryDelay          *int                   `json:"deliveryDelay,omitempty" yaml:"deliveryDelay,omitempty"`
+	VisibilityTimeout      *int                   `json:"visibilityTimeout,omitempty" yaml:"visibilityTimeout,omitempty"`
+	ReceiveMessageWaitTime *int                   `json:"receiveMessageWaitTime,omitempty" yaml:"receiveMessageWaitTime,omitempty"`
+	MessageRetentionPeriod *int                   `json:"messageRetentionPeriod,omitempty" yaml:"messageRetentionPeriod,omitempty"`
+	RedrivePolicy          *SqsRedrivePolicy      `json:"redrivePolicy,omitempty" yaml:"redrivePolicy,omitempty"`
+	Policy                 *AwsPolicy             `json:"policy,omitempty" yaml:"policy,omitempty"`
+	Tags                   map[string]string      `json:"tags,omitempty" yaml:"tags,omitempty"`
+}
+
+func (value *SqsQueue) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{}, 11+len(value.Extensions))
+	for k, v := range value.Extensions {
+		m[k] = v
+	}
+
+	if len(value.Name) != 0 {
+		m["name"] = value.Name
+	}
+	if value.FifoQueue != nil {
+		m["fifoQueue"] = value.FifoQueue
+	}
+	if len(value.DeduplicationScope) != 0 {
+		m["deduplicationScope"] = value.DeduplicationScope
+	}
+	if len(value.FifoThroughputLimit) != 0 {
+		m["fifoThroughputLimit"] = value.FifoThroughputLimit
+	}
+	if value.DeliveryDelay != nil {
+		m["deliveryDelay"] = value.DeliveryDelay
+	}
+	if value.VisibilityTimeout != nil {
+		m["visibilityTimeout"] = value.VisibilityTimeout
+	}
+	if value.ReceiveMessageWaitTime != nil {
+		m["receiveMessageWaitTime"] = value.ReceiveMessageWaitTime
+	}
+	if value.MessageRetentionPeriod != nil {
+		m["messageRetentionPeriod"] = value.MessageRetentionPeriod
+	}
+	if value.RedrivePolicy != nil {
+		m["redrivePolicy"] = value.RedrivePolicy
+	}
+	if value.Policy != nil {
+		m["policy"] = value.Policy
+	}
+	if len(value.Tags) != 0 {
+		m["tags"] = value.Tags
+	}
+
+	return json.Marshal(m)
+}
+
+func (value *SqsQueue) UnmarshalJSON(data []byte) error {
+	type SqsQueueBis SqsQueue
+	var x SqsQueueBis
+	if err := json.Unmarshal(data, &x); err != nil {
+		return err
+	}
+	_ = json.Unmarshal(data, &x.Extensions)
+
+	delete(x.Extensions, "name")
+	delete(x.Extensions, "fifoQueue")
+	delete(x.Extensions, "deduplicationScope")
+	delete(x.Extensions, "fifoThroughputLimit")
+	delete(x.Extensions, "deliveryDelay")
+	delete(x.Extensions, "visibilityTimeout")
+	delete(x.Extensions, "receiveMessageWaitTime")
+	delete(x.Extensions, "messageRetentionPeriod")
+	delete(x.Extensions, "redrivePolicy")
+	delete(x.Extensions, "policy")
+	delete(x.Extensions, "tags")
+
+	*value = SqsQueue(x)
+
+	return nil
+}
+
+func (value *SqsQueue) Validate(ctx context.Context) error {
+	if err := validate.Extensions(ctx, value.Extensions); err != nil {
+		return err
+	}
+
+	if value.Name == "" {
+		err := fmt.Errorf("value of name must be a non-empty string: %w", validate.ErrWrongField)
+		if err := validate.Report(validate.At(ctx, "name"), validate.RuleRequired, err); err != nil {
+			return err
+		}
+	}
+
+	if value.FifoQueue == nil {
+		err := fmt.Errorf("field fifoQueue is required: %w", validate.ErrWrongField)
+		if err := validate.Report(validate.At(ctx, "fifoQueue"), validate.RuleRequired, err); err != nil {
+			return err
+		}
+	}
+
+	switch value.DeduplicationScope {
+	case "", SqsDeduplicationScopeQueue, SqsDeduplicationScopeMessageGroup:
+	default:
+		err := fmt.Errorf("deduplicationScope must be either queue or messageGroup: %w", validate.ErrWrongField)
+		if err := validate.Report(validate.At(ctx, "deduplicationScope"), validate.RuleValue, err); err != nil {
+			return err
+		}
+	}
+
+	switch value.FifoThroughputLimit {
+	case "", SqsFifoThroughputLimitPerQueue, SqsFifoThroughputLimitPerMessageGroupID:
+	default:
+		err := fmt.Errorf(
+			"fifoThroughputLimit must be either perQueue or perMessageGroupId: %w",
+			validate.ErrWrongField,
+		)
+		if err := validate.Report(validate.At(ctx, "fifoThroughputLimit"), validate.RuleValue, err); err != nil {
+			return err
+		}
+	}
+
+	limits := []struct {
+		field    string
+		value    *int
+		min, max int
+	}{
+		{"deliveryDelay", value.DeliveryDelay, 0, 900},
+		{"visibilityTimeout", value.VisibilityTimeout, 0, 43200},
+		{"receiveMessageWaitTime", value.ReceiveMessageWaitTime, 0, 20},
+		{"messageRetentionPeriod", value.MessageRetentionPeriod, 60, 1209600},
+	}
+
+	for _, v := range limits {
+		if err := validateRange(validate.At(ctx, v.field), v.value, v.min, v.max); err != nil {
+			return err
+		}
+	}
+
+	if v := value.RedrivePolicy; v != nil {
+		if err := v.Validate(validate.At(ctx, "redrivePolicy")); err != nil {
+			return err
+		}
+	}
+
+	if v := value.Policy; v != nil {
+		if err := v.Validate(validate.At(ctx, "policy")); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SqsRedrivePolicy describes where messages failed to be processed go.
+type SqsRedrivePolicy struct {
+	Extensions map[string]interface{} `json:"-" yaml:"-"`
+
+	DeadLetterQueue *SqsIdentifier `json:"deadLetterQueue,omitempty" yaml:"deadLetterQueue,omitempty"`
+	MaxReceiveCount *int           `json:"maxReceiveCount,omitempty" yaml:"maxReceiveCount,omitempty"`
+}
+
+func (value *SqsRedrivePolicy) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{}, 2+len(value.Extensions))
+	for k, v := range value.Extensions {
+		m[k] = v
+	}
+
+	if value.DeadLetterQueue != nil {
+		m["deadLetterQueue"] = value.DeadLetterQueue
+	}
+	if value.MaxReceiveCount != nil {
+		m["maxReceiveCount"] = value.MaxReceiveCount
+	}
+
+	return json.Marshal(m)
+}
+
+func (value *SqsRedrivePolicy) UnmarshalJSON(data []byte) error {
+	type SqsRedrivePolicyBis SqsRedrivePolicy
+	var x SqsRedrivePolicyBis
+	if err := json.Unmarshal(data, &x); err != nil {
+		return err
+	}
+	_ = json.Unmarshal(data, &x.Extensions)
+
+	delete(x.Extensions, "deadLetterQueue")
+	delete(x.Extensions, "maxReceiveCount")
+
+	*value = SqsRedrivePolicy(x)
+
+	return nil
+}
+
+func (value *SqsRedrivePolicy) Validate(ctx context.Context) error {
+	if err := validate.Extensions(ctx, value.Extensions); err != nil {
+		return err
+	}
+
+	if v := value.DeadLetterQueue; v != nil {
+		if err := v.Validate(validate.At(ctx, "deadLetterQueue")); err != nil {
+			return err
+		}
+	} else {
+		err := fmt.Errorf("field deadLetterQueue is required: %w", validate.ErrWrongField)
+		if err := validate.Report(validate.At(ctx, "deadLetterQueue"), validate.RuleRequired, err); err != nil {
+			return err
+		}
+	}
+
+	return validateRange(validate.At(ctx, "maxReceiveCount"), value.MaxReceiveCount, 1, 1000)
+}
+
+// SqsIdentifier identifies a queue by its ARN or name.
+type SqsIdentifier struct {
+	Extensions map[string]interface{} `json:"-" yaml:"-"`
+
+	ARN  string `json:"arn,omitempty" yaml:"arn,omitempty"`
+	Name string `json:"name,omitempty" yaml:"name,omitempty"`
+}
+
+func (value *SqsIdentifier) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{}, 2+len(value.Extensions))
+	for k, v := range value.Extensions {
+		m[k] = v
+	}
+
+	if len(value.ARN) != 0 {
+		m["arn"] = value.ARN
+	}
+	if len(value.Name) != 0 {
+		m["name"] = value.Name
+	}
+
+	return json.Marshal(m)
+}
+
+func (value *SqsIdentifier) UnmarshalJSON(data []byte) error {
+	type SqsIdentifierBis SqsIdentifier
+	var x SqsIdentifierBis
+	if err := json.Unmarshal(data, &x); err != nil {
+		return err
+	}
+	_ = json.Unmarshal(data, &x.Extensions)
+
+	delete(x.Extensions, "arn")
+	delete(x.Extensions, "name")
+
+	*value = SqsIdentifier(x)
+
+	return nil
+}
+
+func (value *SqsIdentifier) Validate(ctx context.Context) error {
+	if err := validate.Extensions(ctx, value.Extensions); err != nil {
+		return err
+	}
+
+	if value.ARN == "" && value.Name == "" {
+		err := fmt.Errorf("one of arn or name must be set: %w", validate.ErrWrongField)
+		if err := validate.Report(ctx, validate.RuleRequired, err); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SqsOperation is defined in AsyncAPI spec: https://github.com/asyncapi/bindings/tree/master/sqs#operation-binding-object
+type SqsOperation struct {
+	Extensions map[string]interface{} `json:"-" yaml:"-"`
+
+	Queues         []*SqsQueue `json:"queues,omitempty" yaml:"queues,omitempty"`
+	BindingVersion string      `json:"bindingVersion,omitempty" yaml:"bindingVersion,omitempty"`
+}
+
+func (binding *SqsOperation) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{}, 2+len(binding.Extensions))
+	for k, v := range binding.Extensions {
+		m[k] = v
+	}
+
+	if len(binding.Queues) != 0 {
+		m["queues"] = binding.Queues
+	}
+	if len(binding.BindingVersion) != 0 {
+		m["bindingVersion"] = binding.BindingVersion
+	}
+
+	return json.Marshal(m)
+}
+
+func (binding *SqsOperation) UnmarshalJSON(data []byte) error {
+	type SqsOperationBis SqsOperation
+	var x SqsOperationBis
+	if err := json.Unmarshal(data, &x); err != nil {
+		return err
+	}
+	_ = json.Unmarshal(data, &x.Extensions)
+
+	delete(x.Extensions, "queues")
+	delete(x.Extensions, "bindingVersion")
+
+	*binding = SqsOperation(x)
+
+	return nil
+}
+
+func (binding *SqsOperation) Validate(ctx context.Context) error {
+	if err := validate.Extensions(ctx, binding.Extensions); err != nil {
+		return err
+	}
+
+	err := validateVersionedFields(ctx, "sqs", binding.BindingVersion, []versionedField{
+		{name: "queues", since: sqsQueuesAdded, set: len(binding.Queues) != 0},
+	})
+	if err != nil {
+		return err
+	}
+
+	// Operations have no fields before 0.2.0.
+	if compareBindingVersions(effectiveBindingVersion("sqs", binding.BindingVersion), sqsQueuesAdded) < 0 {
+		return nil
+	}
+
+	if len(binding.Queues) == 0 {
+		err := fmt.Errorf("queues must have at least one queue: %w", validate.ErrWrongField)
+		if err := validate.Report(validate.At(ctx, "queues"), validate.RuleRequired, err); err != nil {
+			return err
+		}
+	}
+
+	for i, v := range binding.Queues {
+		if v == nil {
+			continue
+		}
+
+		if err := v.Validate(validate.At(ctx, "queues", strconv.Itoa(i))); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SqsMessage is defined in AsyncAPI spec: https://github.com/asyncapi/bindings/tree/master/sqs#message-binding-object
+type SqsMessage struct {
+	Extensions map[string]interface{} `json:"-" yaml:"-"`
+
+	BindingVersion string `json:"bindingVersion,omitempty" yaml:"bindingVersion,omitempty"`
+}
+
+func (binding *SqsMessage) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{}, 1+len(binding.Extensions))
+	for k, v := range binding.Extensions {
+		m[k] = v
+	}
+
+	if len(binding.BindingVersion) != 0 {
+		m["bindingVersion"] = binding.BindingVersion
+	}
+
+	return json.Marshal(m)
+}
+
+func (binding *SqsMessage) UnmarshalJSON(data []byte) error {
+	type SqsMessageBis SqsMessage
+	var x SqsMessageBis
+	if err := json.Unmarshal(data, &x); err != nil {
+		return err
+	}
+	_ = json.Unmarshal(data, &x.Extensions)
+
+	delete(x.Extensions, "bindingVersion")
+
+	*binding = SqsMessage(x)
+
+	return nil
+}
+
+func (binding *SqsMessage) Validate(ctx context.Context) error {
+	if err := validate.Extensions(ctx, binding.Extensions); err != nil {
+		return err
+	}
+
+	if err := validateBindingVersion(ctx, "sqs", binding.BindingVersion); err != nil {
+		return err
+	}
+
+	return nil
+}
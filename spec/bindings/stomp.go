@@ -0,0 +1,196 @@
+package bindings
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/rdmrcv/go-asyncapi2/spec/validate"
+)
+
+// StompServer is defined in AsyncAPI spec: https://github.com/asyncapi/bindings/tree/master/stomp#server-binding-object
+type StompServer struct {
+	Extensions map[string]interface{} `json:"-" yaml:"-"`
+
+	BindingVersion string `json:"bindingVersion,omitempty" yaml:"bindingVersion,omitempty"`
+}
+
+func (binding *StompServer) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{}, 1+len(binding.Extensions))
+	for k, v := range binding.Extensions {
+		m[k] = v
+	}
+
+	if len(binding.BindingVersion) != 0 {
+		m["bindingVersion"] = binding.BindingVersion
+	}
+
+	return json.Marshal(m)
+}
+
+func (binding *StompServer) UnmarshalJSON(data []byte) error {
+	type StompServerBis StompServer
+	var x StompServerBis
+	if err := json.Unmarshal(data, &x); err != nil {
+		return err
+	}
+	_ = json.Unmarshal(data, &x.Extensions)
+
+	delete(x.Extensions, "bindingVersion")
+
+	*binding = StompServer(x)
+
+	return nil
+}
+
+func (binding *StompServer) Validate(ctx context.Context) error {
+	if err := validate.Extensions(ctx, binding.Extensions); err != nil {
+		return err
+	}
+
+	if err := validateBindingVersion(ctx, "stomp", binding.BindingVersion); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// StompChannel is defined in AsyncAPI spec: https://github.com/asyncapi/bindings/tree/master/stomp#channel-binding-object
+type StompChannel struct {
+	Extensions map[string]interface{} `json:"-" yaml:"-"`
+
+	BindingVersion string `json:"bindingVersion,omitempty" yaml:"bindingVersion,omitempty"`
+}
+
+func (binding *StompChannel) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{}, 1+len(binding.Extensions))
+	for k, v := range binding.Extensions {
+		m[k] = v
+	}
+
+	if len(binding.BindingVersion) != 0 {
+		m["bindingVersion"] = binding.BindingVersion
+	}
+
+	return json.Marshal(m)
+}
+
+func (binding *StompChannel) UnmarshalJSON(data []byte) error {
+	type StompChannelBis StompChannel
+	var x StompChannelBis
+	if err := json.Unmarshal(data, &x); err != nil {
+		return err
+	}
+	_ = json.Unmarshal(data, &x.Extensions)
+
+	delete(x.Extensions, "bindingVersion")
+
+	*binding = StompChannel(x)
+
+	return nil
+}
+
+func (binding *StompChannel) Validate(ctx context.Context) error {
+	if err := validate.Extensions(ctx, binding.Extensions); err != nil {
+		return err
+	}
+
+	if err := validateBindingVersion(ctx, "stomp", binding.BindingVersion); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// StompOperation is defined in AsyncAPI spec: https://github.com/asyncapi/bindings/tree/master/stomp#operation-binding-object
+type StompOperation struct {
+	Extensions map[string]interface{} `json:"-" yaml:"-"`
+
+	BindingVersion string `json:"bindingVersion,omitempty" yaml:"bindingVersion,omitempty"`
+}
+
+func (binding *StompOperation) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{}, 1+len(binding.Extensions))
+	for k, v := range binding.Extensions {
+		m[k] = v
+	}
+
+	if len(binding.BindingVersion) != 0 {
+		m["bindingVersion"] = binding.BindingVersion
+	}
+
+	return json.Marshal(m)
+}
+
+func (binding *StompOperation) UnmarshalJSON(data []byte) error {
+	type StompOperationBis StompOperation
+	var x StompOperationBis
+	if err := json.Unmarshal(data, &x); err != nil {
+		return err
+	}
+	_ = json.Unmarshal(data, &x.Extensions)
+
+	delete(x.Extensions, "bindingVersion")
+
+	*binding = StompOperation(x)
+
+	return nil
+}
+
+func (binding *StompOperation) Validate(ctx context.Context) error {
+	if err := validate.Extensions(ctx, binding.Extensions); err != nil {
+		return err
+	}
+
+	if err := validateBindingVersion(ctx, "stomp", binding.BindingVersion); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// StompMessage is defined in AsyncAPI spec: https://github.com/asyncapi/bindings/tree/master/stomp#message-binding-object
+type StompMessage struct {
+	Extensions map[string]interface{} `json:"-" yaml:"-"`
+
+	BindingVersion string `json:"bindingVersion,omitempty" yaml:"bindingVersion,omitempty"`
+}
+
+func (binding *StompMessage) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{}, 1+len(binding.Extensions))
+	for k, v := range binding.Extensions {
+		m[k] = v
+	}
+
+	if len(binding.BindingVersion) != 0 {
+		m["bindingVersion"] = binding.BindingVersion
+	}
+
+	return json.Marshal(m)
+}
+
+func (binding *StompMessage) UnmarshalJSON(data []byte) error {
+	type StompMessageBis StompMessage
+	var x StompMessageBis
+	if err := json.Unmarshal(data, &x); err != nil {
+		return err
+	}
+	_ = json.Unmarshal(data, &x.Extensions)
+
+	delete(x.Extensions, "bindingVersion")
+
+	*binding = StompMessage(x)
+
+	return nil
+}
+
+func (binding *StompMessage) Validate(ctx context.Context) error {
+	if err := validate.Extensions(ctx, binding.Extensions); err != nil {
+		return err
+	}
+
+	if err := validateBindingVersion(ctx, "stomp", binding.BindingVersion); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,18 @@
+package bindings
+
+import (
+	"testing"
+)
+
+func TestStompBindings_UnmarshalJSON(t *testing.T) {
+	data := []byte(`{"bindingVersion": "0.1.0", "x-destination": "/queue/orders"}`)
+
+	bindings := []interface{}{&StompServer{}, &StompChannel{}, &StompOperation{}, &StompMessage{}}
+	for _, binding := range bindings {
+		checkRoundTrip(t, data, binding)
+	}
+
+	if v := bindings[1].(*StompChannel); v.BindingVersion != "0.1.0" || v.Extensions["x-destination"] != "/queue/orders" {
+		t.Errorf("unexpected binding %+v", v)
+	}
+}
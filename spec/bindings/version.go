@@ -0,0 +1,163 @@
+package bindings
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/rdmrcv/go-asyncapi2/spec/validate"
+)
+
+var ErrUnsupportedBindingVersion = errors.New("unsupported binding version")
+
+// Upgrader is implemented by bindings whose shape changes between versions.
+type Upgrader interface {
+	// Upgrade rewrites the binding into the shape of the latest version.
+	Upgrade() error
+}
+
+// bindingVersions lists known versions of bindings of protocols, the latest is the last.
+var bindingVersions = map[string][]string{
+	"http":         {"0.1.0", "0.2.0", "0.3.0"},
+	"ws":           {"0.1.0"},
+	"kafka":        {"0.1.0", "0.3.0", "0.4.0"},
+	"amqp":         {"0.1.0", "0.2.0", "0.3.0"},
+	"amqp1":        {"0.1.0"},
+	"mqtt":         {"0.1.0", "0.2.0"},
+	"mqtt5":        {"0.2.0"},
+	"nats":         {"0.1.0"},
+	"jms":          {"0.0.1"},
+	"sns":          {"0.1.0"},
+	"sqs":          {"0.1.0", "0.2.0"},
+	"stomp":        {"0.1.0"},
+	"redis":        {"0.1.0"},
+	"ibmmq":        {"0.1.0"},
+	"googlepubsub": {"0.1.0", "0.2.0"},
+	"solace":       {"0.1.0", "0.2.0", "0.3.0", "0.4.0"},
+	"pulsar":       {"0.1.0"},
+}
+
+// BindingVersions returns known versions of bindings of the protocol, the latest is the last.
+func BindingVersions(protocol string) []string {
+	return append([]string(nil), bindingVersions[protocol]...)
+}
+
+// LatestBindingVersion returns the latest known version of bindings of the protocol.
+func LatestBindingVersion(protocol string) string {
+	versions := bindingVersions[protocol]
+	if len(versions) == 0 {
+		return ""
+	}
+
+	return versions[len(versions)-1]
+}
+
+// IsBindingVersion reports whether the version of bindings of the protocol is known.
+func IsBindingVersion(protocol, version string) bool {
+	for _, v := range bindingVersions[protocol] {
+		if v == version {
+			return true
+		}
+	}
+
+	return false
+}
+
+// effectiveBindingVersion returns the version, or the latest version of the protocol when it is not set.
+func effectiveBindingVersion(protocol, version string) string {
+	if version == "" {
+		return LatestBindingVersion(protocol)
+	}
+
+	return version
+}
+
+// validateBindingVersion reports versions of bindings of the protocol that are not known.
+func validateBindingVersion(ctx context.Context, protocol, version string) error {
+	if version == "" || IsBindingVersion(protocol, version) {
+		return nil
+	}
+
+	err := fmt.Errorf(
+		"%w %q of %s bindings, should be one of %q: %w",
+		ErrUnsupportedBindingVersion, version, protocol, bindingVersions[protocol], validate.ErrWrongField,
+	)
+
+	return validate.Report(validate.At(ctx, "bindingVersion"), validate.RuleValue, err)
+}
+
+// versionedField is a field of bindings that is defined since the version, and until the removed one when set.
+// The name is the path of the field in the binding, separated by "/".
+type versionedField struct {
+	name    string
+	since   string
+	removed string
+	set     bool
+}
+
+// validateVersionedFields checks that the version of bindings of the protocol is known and set fields
+// are defined in it. Bindings without the version may hold fields of any version.
+func validateVersionedFields(ctx context.Context, protocol, version string, fields []versionedField) error {
+	if err := validateBindingVersion(ctx, protocol, version); err != nil {
+		return err
+	}
+
+	if version == "" || !IsBindingVersion(protocol, version) {
+		return nil
+	}
+
+	for _, v := range fields {
+		if !v.set {
+			continue
+		}
+
+		var err error
+		switch {
+		case v.since != "" && compareBindingVersions(version, v.since) < 0:
+			err = fmt.Errorf(
+				"%s is defined since binding version %s, got %s: %w",
+				v.name, v.since, version, validate.ErrWrongField,
+			)
+		case v.removed != "" && compareBindingVersions(version, v.removed) >= 0:
+			err = fmt.Errorf(
+				"%s is removed in binding version %s, got %s: %w",
+				v.name, v.removed, version, validate.ErrWrongField,
+			)
+		default:
+			continue
+		}
+
+		if err := validate.Report(validate.At(ctx, strings.Split(v.name, "/")...), validate.RuleValue, err); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// compareBindingVersions compares versions like "0.3.0" and returns -1, 0 or 1.
+// Missing or malformed parts count as 0.
+func compareBindingVersions(a, b string) int {
+	as, bs := strings.Split(a, "."), strings.Split(b, ".")
+
+	for i := 0; i < len(as) || i < len(bs); i++ {
+		var x, y int
+		if i < len(as) {
+			x, _ = strconv.Atoi(as[i])
+		}
+		if i < len(bs) {
+			y, _ = strconv.Atoi(bs[i])
+		}
+
+		switch {
+		case x < y:
+			return -1
+		case x > y:
+			return 1
+		}
+	}
+
+	return 0
+}
@@ -0,0 +1,132 @@
+package bindings
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/rdmrcv/go-asyncapi2/spec/validate"
+)
+
+func TestBindings_ValidateVersionedFields(t *testing.T) {
+	number := func(v int) *int { return &v }
+	flag := true
+
+	tests := []struct {
+		name     string
+		binding  interface{ Validate(context.Context) error }
+		pointers []string
+	}{
+		{
+			name:     "unsupported version",
+			binding:  &AmqpMessage{BindingVersion: "0.4.0"},
+			pointers: []string{"/bindingVersion"},
+		},
+		{
+			name:     "unsupported nats version",
+			binding:  &NatsOperation{Queue: "messages", BindingVersion: "0.2.0"},
+			pointers: []string{"/bindingVersion"},
+		},
+		{
+			name:     "unsupported ibmmq version",
+			binding:  &IbmMqServer{BindingVersion: "1.0.0"},
+			pointers: []string{"/bindingVersion"},
+		},
+		{
+			name:     "unsupported pulsar version",
+			binding:  &PulsarChannel{Namespace: "staging", Persistence: PulsarPersistent, BindingVersion: "0.2.0"},
+			pointers: []string{"/bindingVersion"},
+		},
+		{
+			name:    "supported pulsar version",
+			binding: &PulsarChannel{Namespace: "staging", Persistence: PulsarPersistent, BindingVersion: "0.1.0"},
+		},
+		{
+			name: "amqp vhost before 0.2.0",
+			binding: &AmqpChannel{
+				Is:             AmqpChannelIsRoutingKey,
+				Exchange:       &AmqpExchange{Name: "orders", Type: AmqpExchangeTopic, Vhost: "/"},
+				BindingVersion: "0.1.0",
+			},
+			pointers: []string{"/exchange/vhost"},
+		},
+		{
+			name:     "amqp replyTo since 0.3.0",
+			binding:  &AmqpOperation{ReplyTo: "replies", BindingVersion: "0.3.0"},
+			pointers: []string{"/replyTo"},
+		},
+		{
+			name:    "amqp replyTo before 0.3.0",
+			binding: &AmqpOperation{ReplyTo: "replies", BindingVersion: "0.2.0"},
+		},
+		{
+			name:    "amqp replyTo without version",
+			binding: &AmqpOperation{ReplyTo: "replies"},
+		},
+		{
+			name: "mqtt 5 fields before 0.2.0",
+			binding: &MqttMessage{
+				PayloadFormatIndicator: number(MqttPayloadFormatUTF8),
+				ContentType:            "application/json",
+				BindingVersion:         "0.1.0",
+			},
+			pointers: []string{"/payloadFormatIndicator", "/contentType"},
+		},
+		{
+			name: "mqtt 5 fields",
+			binding: &MqttServer{
+				SessionExpiryInterval: &MqttValue[int]{Value: number(-1)},
+				MaximumPacketSize:     &MqttValue[int]{Value: number(0)},
+				BindingVersion:        "0.2.0",
+			},
+			pointers: []string{"/sessionExpiryInterval", "/maximumPacketSize"},
+		},
+		{
+			name:     "sqs queue before 0.2.0",
+			binding:  &SqsChannel{Queue: &SqsQueue{Name: "orders"}, BindingVersion: "0.1.0"},
+			pointers: []string{"/queue"},
+		},
+		{
+			name:    "empty sqs operation of 0.1.0",
+			binding: &SqsOperation{BindingVersion: "0.1.0"},
+		},
+		{
+			name: "solace fields before 0.4.0",
+			binding: &SolaceOperation{
+				Destinations: []*SolaceDestination{{
+					DestinationType: SolaceDestinationQueue,
+					Queue:           &SolaceQueue{Name: "orders", MaxTTL: "60"},
+				}},
+				DmqEligible:    &flag,
+				BindingVersion: "0.2.0",
+			},
+			pointers: []string{"/dmqEligible", "/destinations/0/queue/maxTtl"},
+		},
+		{
+			name:     "googlepubsub topic since 0.2.0",
+			binding:  &GooglePubSubChannel{Topic: "projects/orders/topics/created", BindingVersion: "0.2.0"},
+			pointers: []string{"/topic"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validate.Collect(context.Background(), tt.binding.Validate)
+
+			var errs validate.Errors
+			if err != nil && !errors.As(err, &errs) {
+				t.Fatalf("expected validate.Errors, got %v", err)
+			}
+
+			if len(errs) != len(tt.pointers) {
+				t.Fatalf("expected errors at %v, got %v", tt.pointers, err)
+			}
+
+			for i, v := range tt.pointers {
+				if errs[i].Pointer != v {
+					t.Errorf("expected error at %s, got %s", v, errs[i].Pointer)
+				}
+			}
+		})
+	}
+}
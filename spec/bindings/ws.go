@@ -70,18 +70,29 @@ func (binding *WsChannel) UnmarshalJSON(data []byte) error {
 }
 
 func (binding *WsChannel) Validate(ctx context.Context) error {
+	if err := validate.Extensions(ctx, binding.Extensions); err != nil {
+		return err
+	}
+
+	if err := validateBindingVersion(ctx, "ws", binding.BindingVersion); err != nil {
+		return err
+	}
+
 	if binding.Method != http.MethodGet && binding.Method != http.MethodPost {
-		return fmt.Errorf("method value MUST be either GET or POST: %w", validate.ErrWrongField)
+		err := fmt.Errorf("method value MUST be either GET or POST: %w", validate.ErrWrongField)
+		if err := validate.Report(validate.At(ctx, "method"), validate.RuleValue, err); err != nil {
+			return err
+		}
 	}
 
 	if v := binding.Query; v != nil {
-		if err := v.Validate(ctx); err != nil {
+		if err := validate.Schema(validate.At(ctx, "query"), v); err != nil {
 			return err
 		}
 	}
 
 	if v := binding.Headers; v != nil {
-		if err := v.Validate(ctx); err != nil {
+		if err := validate.Schema(validate.At(ctx, "headers"), v); err != nil {
 			return err
 		}
 	}
@@ -0,0 +1,105 @@
+package spec
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrChannelNotFound     = errors.New("channel is not found")
+	ErrInvalidChannelParam = errors.New("invalid channel parameter")
+)
+
+// Address returns the address of the channel with the name, see Channel.Address.
+func (channels Channels) Address(name string, params map[string]interface{}) (string, error) {
+	channel, ok := channels[name]
+	if !ok {
+		return "", fmt.Errorf("%q: %w", name, ErrChannelNotFound)
+	}
+
+	return channel.Address(name, params)
+}
+
+// Address replaces parameters in the channel name with their values. Values are checked
+// against schemas of parameters, so ChannelRouter matches the address to the channel.
+// All parameters used in the name must have values, and only them.
+func (value *Channel) Address(name string, params map[string]interface{}) (string, error) {
+	used, err := parseChannelName(name)
+	if err != nil {
+		return "", fmt.Errorf("channel %q: %w", name, err)
+	}
+
+	raw := make(map[string]string, len(used))
+
+	for _, param := range used {
+		if _, ok := raw[param]; ok {
+			continue
+		}
+
+		v, ok := params[param]
+		if !ok {
+			return "", fmt.Errorf("parameter %q has no value: %w", param, ErrInvalidChannelParam)
+		}
+
+		s, err := formatChannelParam(v)
+		if err != nil {
+			return "", fmt.Errorf("parameter %q: %w: %w", param, ErrInvalidChannelParam, err)
+		}
+
+		if _, err := decodeChannelParam(value, param, s); err != nil {
+			return "", fmt.Errorf("parameter %q: %w: %w", param, ErrInvalidChannelParam, err)
+		}
+
+		raw[param] = s
+	}
+
+	for _, param := range sortedKeys(params) {
+		if _, ok := raw[param]; !ok {
+			return "", fmt.Errorf("parameter %q is not used in the channel name: %w", param, ErrInvalidChannelParam)
+		}
+	}
+
+	var address strings.Builder
+
+	for rest := name; rest != ""; {
+		opening := strings.IndexByte(rest, '{')
+		if opening < 0 {
+			address.WriteString(rest)
+			break
+		}
+
+		closing := strings.IndexByte(rest, '}')
+		address.WriteString(rest[:opening])
+		address.WriteString(raw[rest[opening+1:closing]])
+		rest = rest[closing+1:]
+	}
+
+	return address.String(), nil
+}
+
+func formatChannelParam(value interface{}) (string, error) {
+	var s string
+
+	switch v := value.(type) {
+	case string:
+		s = v
+	case float64:
+		s = strconv.FormatFloat(v, 'f', -1, 64)
+	case float32:
+		s = strconv.FormatFloat(float64(v), 'f', -1, 32)
+	case bool, int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		s = fmt.Sprint(v)
+	case fmt.Stringer:
+		s = v.String()
+	default:
+		return "", fmt.Errorf("unsupported type %T", value)
+	}
+
+	if s == "" || strings.Contains(s, "/") {
+		return "", fmt.Errorf("value %q should be non-empty and should not contain /", s)
+	}
+
+	return s, nil
+}
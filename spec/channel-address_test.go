@@ -0,0 +1,108 @@
+package spec
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+func TestChannels_Address(t *testing.T) {
+	channels := Channels{
+		"smartylighting/streetlights/1/0/event/{streetlightId}/lighting/measured": &Channel{
+			Parameters: ParametersRefs{"streetlightId": &ParameterRef{Value: &Parameter{Schema: &openapi3.Schema{
+				Type: &openapi3.Types{"integer"},
+				Min:  openapi3.Float64Ptr(1),
+			}}}},
+		},
+		"user.{userId}.{event}": &Channel{},
+	}
+
+	tests := []struct {
+		name    string
+		params  map[string]interface{}
+		address string
+		err     error
+	}{
+		{
+			name:    "smartylighting/streetlights/1/0/event/{streetlightId}/lighting/measured",
+			params:  map[string]interface{}{"streetlightId": 42},
+			address: "smartylighting/streetlights/1/0/event/42/lighting/measured",
+		},
+		{
+			name:    "smartylighting/streetlights/1/0/event/{streetlightId}/lighting/measured",
+			params:  map[string]interface{}{"streetlightId": "42"},
+			address: "smartylighting/streetlights/1/0/event/42/lighting/measured",
+		},
+		{
+			name:   "smartylighting/streetlights/1/0/event/{streetlightId}/lighting/measured",
+			params: map[string]interface{}{"streetlightId": 0},
+			err:    ErrInvalidChannelParam,
+		},
+		{
+			name:   "smartylighting/streetlights/1/0/event/{streetlightId}/lighting/measured",
+			params: map[string]interface{}{"streetlightId": "first"},
+			err:    ErrInvalidChannelParam,
+		},
+		{
+			name:    "user.{userId}.{event}",
+			params:  map[string]interface{}{"userId": "u1", "event": "signedup"},
+			address: "user.u1.signedup",
+		},
+		{
+			name:   "user.{userId}.{event}",
+			params: map[string]interface{}{"userId": "u1"},
+			err:    ErrInvalidChannelParam,
+		},
+		{
+			name:   "user.{userId}.{event}",
+			params: map[string]interface{}{"userId": "u1", "event": "a/b"},
+			err:    ErrInvalidChannelParam,
+		},
+		{
+			name:   "user.{userId}.{event}",
+			params: map[string]interface{}{"userId": "u1", "event": "signedup", "extra": 1},
+			err:    ErrInvalidChannelParam,
+		},
+		{
+			name: "unknown",
+			err:  ErrChannelNotFound,
+		},
+	}
+
+	router, err := NewChannelRouter(channels)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			address, err := channels.Address(tt.name, tt.params)
+			if tt.err != nil {
+				if !errors.Is(err, tt.err) {
+					t.Fatalf("expected %v, got %v", tt.err, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if address != tt.address {
+				t.Errorf("expected %s, got %s", tt.address, address)
+			}
+
+			match, err := router.Match(context.Background(), address)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if match.Name != tt.name {
+				t.Errorf("expected the address to match %s, got %s", tt.name, match.Name)
+			}
+		})
+	}
+}
@@ -0,0 +1,322 @@
+package spec
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+
+	"github.com/rdmrcv/go-asyncapi2/spec/validate"
+)
+
+var ErrNoChannelMatch = errors.New("no channel matches the address")
+
+// ChannelMatch is the channel matching an address.
+type ChannelMatch struct {
+	// Name is the key of the channel in the channels object.
+	Name    string
+	Channel *Channel
+	// Params holds values of parameters, converted to types their schemas declare.
+	Params map[string]interface{}
+	// RawParams holds values of parameters as they are in the address.
+	RawParams map[string]string
+}
+
+// ChannelRouter finds channels by concrete addresses like "streetlights/42/lighting/measured".
+//
+// Parameters match non-empty parts of addresses without "/". When several channels match the address,
+// the one with more literal characters in the leftmost differing segment wins, then the one with the lesser name.
+type ChannelRouter struct {
+	root *channelNode
+}
+
+// channelNode matches one "/" separated segment of addresses.
+type channelNode struct {
+	literals map[string]*channelNode
+	// patterns are segments with parameters, in the order they are tried.
+	patterns []*channelPattern
+	// channels end at the node, in the order they are tried.
+	channels []*routedChannel
+}
+
+type channelPattern struct {
+	segment string
+	re      *regexp.Regexp
+	params  []string
+	// literal is the number of literal characters in the segment.
+	literal int
+	node    *channelNode
+}
+
+type routedChannel struct {
+	name    string
+	channel *Channel
+}
+
+// NewChannelRouter builds the router of the channels. Refs of parameters should be resolved,
+// otherwise values of their parameters are not checked.
+func NewChannelRouter(channels Channels) (*ChannelRouter, error) {
+	router := &ChannelRouter{root: newChannelNode()}
+
+	for _, name := range sortedKeys(channels) {
+		if _, err := parseChannelName(name); err != nil {
+			return nil, fmt.Errorf("channel %q: %w", name, err)
+		}
+
+		node := router.root
+		for _, segment := range strings.Split(name, "/") {
+			node = node.child(segment)
+		}
+
+		node.channels = append(node.channels, &routedChannel{name: name, channel: channels[name]})
+	}
+
+	router.root.sort()
+
+	return router, nil
+}
+
+func newChannelNode() *channelNode {
+	return &channelNode{literals: make(map[string]*channelNode)}
+}
+
+func (node *channelNode) child(segment string) *channelNode {
+	if !strings.Contains(segment, "{") {
+		next, ok := node.literals[segment]
+		if !ok {
+			next = newChannelNode()
+			node.literals[segment] = next
+		}
+
+		return next
+	}
+
+	for _, v := range node.patterns {
+		if v.segment == segment {
+			return v.node
+		}
+	}
+
+	pattern := &channelPattern{segment: segment, node: newChannelNode()}
+
+	var expr strings.Builder
+	expr.WriteByte('^')
+
+	for rest := segment; rest != ""; {
+		opening := strings.IndexByte(rest, '{')
+		if opening < 0 {
+			opening = len(rest)
+		}
+
+		expr.WriteString(regexp.QuoteMeta(rest[:opening]))
+		pattern.literal += opening
+		rest = rest[opening:]
+
+		if rest == "" {
+			break
+		}
+
+		closing := strings.IndexByte(rest, '}')
+		pattern.params = append(pattern.params, rest[1:closing])
+		expr.WriteString("(.+?)")
+		rest = rest[closing+1:]
+	}
+
+	expr.WriteByte('$')
+	pattern.re = regexp.MustCompile(expr.String())
+
+	node.patterns = append(node.patterns, pattern)
+
+	return pattern.node
+}
+
+// sort orders patterns by their specificity, so matching is deterministic.
+func (node *channelNode) sort() {
+	sort.Slice(node.patterns, func(i, j int) bool {
+		a, b := node.patterns[i], node.patterns[j]
+		if a.literal != b.literal {
+			return a.literal > b.literal
+		}
+
+		return a.segment < b.segment
+	})
+
+	for _, v := range node.literals {
+		v.sort()
+	}
+
+	for _, v := range node.patterns {
+		v.node.sort()
+	}
+}
+
+// Match returns the channel matching the address.
+//
+// Channels with parameter values not matching their schemas are skipped, schema options of the context apply.
+// When no channel matches, the error of the first channel matching the address with such values is returned,
+// or ErrNoChannelMatch.
+func (router *ChannelRouter) Match(ctx context.Context, address string) (*ChannelMatch, error) {
+	schemaOpts := validate.GetOptions(ctx).SchemaVisitOptions()
+
+	var firstErr error
+
+	var match *ChannelMatch
+
+	router.root.match(strings.Split(address, "/"), map[string]string{}, func(v *routedChannel, params map[string]string) bool {
+		decoded, err := decodeChannelParams(v.channel, params, schemaOpts)
+		if err != nil {
+			if firstErr == nil {
+				firstErr = fmt.Errorf("channel %q: %w", v.name, err)
+			}
+
+			return false
+		}
+
+		raw := make(map[string]string, len(params))
+		for k, value := range params {
+			raw[k] = value
+		}
+
+		match = &ChannelMatch{Name: v.name, Channel: v.channel, Params: decoded, RawParams: raw}
+
+		return true
+	})
+
+	if match != nil {
+		return match, nil
+	}
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+
+	return nil, fmt.Errorf("%q: %w", address, ErrNoChannelMatch)
+}
+
+// match walks nodes matching the segments in the order of their specificity
+// until accept returns true for a channel, and reports whether it did.
+func (node *channelNode) match(
+	segments []string,
+	params map[string]string,
+	accept func(*routedChannel, map[string]string) bool,
+) bool {
+	if len(segments) == 0 {
+		for _, v := range node.channels {
+			if accept(v, params) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	segment, rest := segments[0], segments[1:]
+
+	if next, ok := node.literals[segment]; ok && next.match(rest, params, accept) {
+		return true
+	}
+
+	for _, pattern := range node.patterns {
+		values := pattern.re.FindStringSubmatch(segment)
+		if values == nil {
+			continue
+		}
+
+		added, ok := bindChannelParams(params, pattern.params, values[1:])
+		if ok && pattern.node.match(rest, params, accept) {
+			return true
+		}
+
+		for _, k := range added {
+			delete(params, k)
+		}
+	}
+
+	return false
+}
+
+// bindChannelParams adds values of parameters and returns names of added ones.
+// Parameters used several times in a name must have the same value.
+func bindChannelParams(params map[string]string, names, values []string) ([]string, bool) {
+	var added []string
+
+	for i, name := range names {
+		if prev, ok := params[name]; ok {
+			if prev != values[i] {
+				return added, false
+			}
+
+			continue
+		}
+
+		params[name] = values[i]
+		added = append(added, name)
+	}
+
+	return added, true
+}
+
+// decodeChannelParams converts values of parameters to types their schemas declare and validates them.
+func decodeChannelParams(
+	channel *Channel,
+	params map[string]string,
+	schemaOpts []openapi3.SchemaValidationOption,
+) (map[string]interface{}, error) {
+	decoded := make(map[string]interface{}, len(params))
+
+	for _, name := range sortedKeys(params) {
+		value, err := decodeChannelParam(channel, name, params[name], schemaOpts...)
+		if err != nil {
+			return nil, fmt.Errorf("parameter %q: %w", name, err)
+		}
+
+		decoded[name] = value
+	}
+
+	return decoded, nil
+}
+
+func decodeChannelParam(
+	channel *Channel,
+	name, raw string,
+	schemaOpts ...openapi3.SchemaValidationOption,
+) (interface{}, error) {
+	if channel == nil || channel.Parameters[name] == nil || channel.Parameters[name].Value == nil {
+		return raw, nil
+	}
+
+	schema := channel.Parameters[name].Value.Schema
+	if schema == nil {
+		return raw, nil
+	}
+
+	var value interface{} = raw
+
+	switch {
+	case schema.Type.Is(openapi3.TypeInteger), schema.Type.Is(openapi3.TypeNumber):
+		v, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return nil, err
+		}
+
+		value = v
+	case schema.Type.Is(openapi3.TypeBoolean):
+		v, err := strconv.ParseBool(raw)
+		if err != nil {
+			return nil, err
+		}
+
+		value = v
+	}
+
+	if err := schema.VisitJSON(value, schemaOpts...); err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
@@ -0,0 +1,198 @@
+package spec
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+
+	"github.com/rdmrcv/go-asyncapi2/spec/validate"
+)
+
+func TestChannelRouter_Match(t *testing.T) {
+	integer := &ParameterRef{Value: &Parameter{Schema: &openapi3.Schema{
+		Type: &openapi3.Types{"integer"},
+		Min:  openapi3.Float64Ptr(0),
+	}}}
+	str := &ParameterRef{Value: &Parameter{Schema: &openapi3.Schema{Type: &openapi3.Types{"string"}}}}
+
+	channels := Channels{
+		"streetlights/{streetlightId}/lighting/measured": &Channel{
+			Parameters: ParametersRefs{"streetlightId": integer},
+		},
+		"streetlights/{name}/lighting/measured": &Channel{
+			Parameters: ParametersRefs{"name": str},
+		},
+		"streetlights/main/lighting/measured":  &Channel{},
+		"streetlights/{id}/turn/{id}":          &Channel{},
+		"user.{userId}.signedup":               &Channel{},
+		"user.{userId}.{event}":                &Channel{},
+		"rooms/room-{roomId}/lights/{lightId}": &Channel{},
+	}
+
+	router, err := NewChannelRouter(channels)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		address string
+		name    string
+		params  map[string]interface{}
+		err     error
+	}{
+		{
+			address: "streetlights/main/lighting/measured",
+			name:    "streetlights/main/lighting/measured",
+			params:  map[string]interface{}{},
+		},
+		{
+			address: "streetlights/42/lighting/measured",
+			name:    "streetlights/{name}/lighting/measured",
+			params:  map[string]interface{}{"name": "42"},
+		},
+		{
+			address: "streetlights/7/turn/7",
+			name:    "streetlights/{id}/turn/{id}",
+			params:  map[string]interface{}{"id": "7"},
+		},
+		{
+			address: "streetlights/7/turn/8",
+			err:     ErrNoChannelMatch,
+		},
+		{
+			address: "user.42.signedup",
+			name:    "user.{userId}.signedup",
+			params:  map[string]interface{}{"userId": "42"},
+		},
+		{
+			address: "user.42.signedout",
+			name:    "user.{userId}.{event}",
+			params:  map[string]interface{}{"userId": "42", "event": "signedout"},
+		},
+		{
+			address: "rooms/room-1/lights/2",
+			name:    "rooms/room-{roomId}/lights/{lightId}",
+			params:  map[string]interface{}{"roomId": "1", "lightId": "2"},
+		},
+		{
+			address: "rooms/1/lights/2",
+			err:     ErrNoChannelMatch,
+		},
+		{
+			address: "streetlights/42/lighting",
+			err:     ErrNoChannelMatch,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.address, func(t *testing.T) {
+			match, err := router.Match(context.Background(), tt.address)
+			if tt.err != nil {
+				if !errors.Is(err, tt.err) {
+					t.Fatalf("expected %v, got %v", tt.err, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if match.Name != tt.name {
+				t.Errorf("expected %s, got %s", tt.name, match.Name)
+			}
+
+			if match.Channel != channels[tt.name] {
+				t.Errorf("expected channel %s", tt.name)
+			}
+
+			if !reflect.DeepEqual(match.Params, tt.params) {
+				t.Errorf("expected %v, got %v", tt.params, match.Params)
+			}
+		})
+	}
+}
+
+func TestChannelRouter_Match_Params(t *testing.T) {
+	channels := Channels{
+		"lights/{lightId}": &Channel{
+			Parameters: ParametersRefs{"lightId": &ParameterRef{Value: &Parameter{Schema: &openapi3.Schema{
+				Type: &openapi3.Types{"integer"},
+				Min:  openapi3.Float64Ptr(1),
+			}}}},
+		},
+		"lights/{lightId}/{on}": &Channel{
+			Parameters: ParametersRefs{"on": &ParameterRef{Value: &Parameter{Schema: &openapi3.Schema{
+				Type: &openapi3.Types{"boolean"},
+			}}}},
+		},
+	}
+
+	router, err := NewChannelRouter(channels)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	match, err := router.Match(context.Background(), "lights/3")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if match.Params["lightId"] != float64(3) || match.RawParams["lightId"] != "3" {
+		t.Errorf("unexpected params %v", match.Params)
+	}
+
+	if _, err := router.Match(context.Background(), "lights/0"); err == nil || errors.Is(err, ErrNoChannelMatch) {
+		t.Errorf("expected schema error, got %v", err)
+	}
+
+	match, err = router.Match(context.Background(), "lights/x/true")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if match.Params["on"] != true || match.Params["lightId"] != "x" {
+		t.Errorf("unexpected params %v", match.Params)
+	}
+}
+
+func TestChannelRouter_Match_ContextOptions(t *testing.T) {
+	channels := Channels{
+		"devices/{serial}": &Channel{
+			Parameters: ParametersRefs{"serial": &ParameterRef{Value: &Parameter{Schema: &openapi3.Schema{
+				Type:    &openapi3.Types{"string"},
+				Pattern: "^[A-Z]{2}[0-9]+$",
+			}}}},
+		},
+	}
+
+	router, err := NewChannelRouter(channels)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := router.Match(context.Background(), "devices/42"); err == nil {
+		t.Error("expected pattern error")
+	}
+
+	ctx := validate.WithOptions(context.Background(), validate.DisableSchemaPatternValidation())
+
+	match, err := router.Match(ctx, "devices/42")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if match.Params["serial"] != "42" {
+		t.Errorf("unexpected params %v", match.Params)
+	}
+}
+
+func TestNewChannelRouter_Malformed(t *testing.T) {
+	if _, err := NewChannelRouter(Channels{"lights/{lightId": &Channel{}}); err == nil {
+		t.Error("expected error")
+	}
+}
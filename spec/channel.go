@@ -3,14 +3,27 @@ package spec
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/rdmrcv/go-asyncapi2/spec/validate"
 )
 
 // Channels is defined in AsyncAPI spec: https://github.com/asyncapi/spec/blob/2.0.0/versions/2.0.0/asyncapi.md#channels-object
 type Channels map[string]*Channel
 
+var channelParameterRegexp = regexp.MustCompile(`^[A-Za-z0-9_\-]+$`)
+
 func (h Channels) Validate(ctx context.Context) error {
-	for _, item := range h {
-		if err := item.Validate(ctx); err != nil {
+	for _, k := range sortedKeys(h) {
+		ctx := validate.At(ctx, k)
+
+		if err := h[k].validateName(ctx, k); err != nil {
+			return err
+		}
+
+		if err := h[k].Validate(ctx); err != nil {
 			return err
 		}
 	}
@@ -18,6 +31,76 @@ func (h Channels) Validate(ctx context.Context) error {
 	return nil
 }
 
+// parseChannelName returns names of parameters used in the channel name, in the order they appear.
+func parseChannelName(name string) ([]string, error) {
+	var params []string
+
+	for rest := name; rest != ""; {
+		opening, closing := strings.IndexByte(rest, '{'), strings.IndexByte(rest, '}')
+
+		switch {
+		case opening < 0 && closing < 0:
+			return params, nil
+		case opening < 0 || closing < opening:
+			return nil, fmt.Errorf("channel name has mismatched { and }: %w", validate.ErrWrongField)
+		}
+
+		param := rest[opening+1 : closing]
+		if strings.IndexByte(param, '{') >= 0 {
+			return nil, fmt.Errorf("channel name has mismatched { and }: %w", validate.ErrWrongField)
+		}
+
+		if !channelParameterRegexp.MatchString(param) {
+			return nil, fmt.Errorf(
+				"channel parameter %q should match pattern %q: %w",
+				param,
+				channelParameterRegexp.String(),
+				validate.ErrWrongField,
+			)
+		}
+
+		params = append(params, param)
+		rest = rest[closing+1:]
+	}
+
+	return params, nil
+}
+
+// validateName checks that parameters used in the channel name are declared and declared ones are used.
+func (value *Channel) validateName(ctx context.Context, name string) error {
+	params, err := parseChannelName(name)
+	if err != nil {
+		return validate.Report(ctx, validate.RuleValue, err)
+	}
+
+	used := make(map[string]struct{}, len(params))
+	for _, param := range params {
+		if _, ok := used[param]; ok {
+			continue
+		}
+
+		used[param] = struct{}{}
+
+		if _, ok := value.Parameters[param]; !ok {
+			err := fmt.Errorf("channel parameter %q is not declared: %w", param, validate.ErrWrongField)
+			if err := validate.Report(validate.At(ctx, "parameters"), validate.RuleRequired, err); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, param := range sortedKeys(value.Parameters) {
+		if _, ok := used[param]; !ok {
+			err := fmt.Errorf("channel parameter %q is not used in the channel name: %w", param, validate.ErrWrongField)
+			if err := validate.Report(validate.At(ctx, "parameters", param), validate.RuleValue, err); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
 // Channel is defined in AsyncAPI spec: https://github.com/asyncapi/spec/blob/2.0.0/versions/2.0.0/asyncapi.md#channel-item-object
 type Channel struct {
 	Extensions map[string]interface{} `json:"-" yaml:"-"`
@@ -72,26 +155,30 @@ func (value *Channel) UnmarshalJSON(data []byte) error {
 }
 
 func (value *Channel) Validate(ctx context.Context) error {
+	if err := validate.Extensions(ctx, value.Extensions); err != nil {
+		return err
+	}
+
 	if v := value.Subscribe; v != nil {
-		if err := v.Validate(ctx); err != nil {
+		if err := v.Validate(validate.At(ctx, "subscribe")); err != nil {
 			return err
 		}
 	}
 
 	if v := value.Publish; v != nil {
-		if err := v.Validate(ctx); err != nil {
+		if err := v.Validate(validate.At(ctx, "publish")); err != nil {
 			return err
 		}
 	}
 
 	if v := value.Parameters; v != nil {
-		if err := v.Validate(ctx); err != nil {
+		if err := v.Validate(validate.At(ctx, "parameters")); err != nil {
 			return err
 		}
 	}
 
 	if v := value.Bindings; v != nil {
-		if err := v.Validate(ctx); err != nil {
+		if err := v.Validate(validate.At(ctx, "bindings")); err != nil {
 			return err
 		}
 	}
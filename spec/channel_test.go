@@ -0,0 +1,77 @@
+package spec
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/rdmrcv/go-asyncapi2/spec/validate"
+)
+
+func TestChannels_Validate_Parameters(t *testing.T) {
+	parameter := &ParameterRef{Value: &Parameter{}}
+
+	tests := []struct {
+		name     string
+		channel  string
+		params   []string
+		pointers []string
+	}{
+		{
+			name:    "declared",
+			channel: "lights/{lightId}/rooms/{roomId}",
+			params:  []string{"lightId", "roomId"},
+		},
+		{
+			name:     "not declared",
+			channel:  "lights/{lightId}/rooms/{roomId}",
+			params:   []string{"lightId"},
+			pointers: []string{"/lights~1{lightId}~1rooms~1{roomId}/parameters"},
+		},
+		{
+			name:     "not used",
+			channel:  "lights/{lightId}",
+			params:   []string{"lightId", "roomId"},
+			pointers: []string{"/lights~1{lightId}/parameters/roomId"},
+		},
+		{
+			name:     "mismatched braces",
+			channel:  "lights/{lightId/rooms",
+			params:   []string{"lightId"},
+			pointers: []string{"/lights~1{lightId~1rooms"},
+		},
+		{
+			name:     "malformed name",
+			channel:  "lights/{light id}",
+			pointers: []string{"/lights~1{light id}"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			channel := &Channel{Parameters: ParametersRefs{}}
+			for _, v := range tt.params {
+				channel.Parameters[v] = parameter
+			}
+
+			channels := Channels{tt.channel: channel}
+
+			err := validate.Collect(context.Background(), channels.Validate)
+
+			var errs validate.Errors
+			if err != nil && !errors.As(err, &errs) {
+				t.Fatalf("expected validate.Errors, got %v", err)
+			}
+
+			if len(errs) != len(tt.pointers) {
+				t.Fatalf("expected errors at %v, got %v", tt.pointers, err)
+			}
+
+			for i, v := range tt.pointers {
+				if errs[i].Pointer != v {
+					t.Errorf("expected error at %s, got %s", v, errs[i].Pointer)
+				}
+			}
+		})
+	}
+}
@@ -7,6 +7,8 @@ import (
 	"regexp"
 
 	"github.com/getkin/kin-openapi/openapi3"
+
+	"github.com/rdmrcv/go-asyncapi2/spec/validate"
 )
 
 // Components scheme is defined in AsyncAPI spec: https://github.com/asyncapi/spec/blob/2.0.0/versions/2.0.0/asyncapi.md#componentsObject
@@ -94,120 +96,95 @@ func (components *Components) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
-func (components *Components) Validate(ctx context.Context) (err error) {
-	for k, v := range components.Schemas {
-		if err = ValidateIdentifier(k); err != nil {
-			return
-		}
-		if err = v.Validate(ctx); err != nil {
-			return
-		}
-	}
-
-	for k, v := range components.Messages {
-		if err = ValidateIdentifier(k); err != nil {
-			return
-		}
-		if err = v.Validate(ctx); err != nil {
-			return
-		}
-	}
-
-	for k, v := range components.SecuritySchemes {
-		if err = ValidateIdentifier(k); err != nil {
-			return
-		}
-		if err = v.Validate(ctx); err != nil {
-			return
-		}
-	}
-
-	for k, v := range components.Parameters {
-		if err = ValidateIdentifier(k); err != nil {
-			return
-		}
-		if err = v.Validate(ctx); err != nil {
-			return
-		}
-	}
-
-	for k, v := range components.CorrelationIds {
-		if err = ValidateIdentifier(k); err != nil {
-			return
-		}
-		if err = v.Validate(ctx); err != nil {
-			return
-		}
+func (components *Components) Validate(ctx context.Context) error {
+	if err := validate.Extensions(ctx, components.Extensions); err != nil {
+		return err
 	}
 
-	for k, v := range components.OperationTraits {
-		if err = ValidateIdentifier(k); err != nil {
-			return
+	for _, k := range sortedKeys(components.Schemas) {
+		ctx := validate.At(ctx, "schemas", k)
+		if err := validate.Report(ctx, validate.RuleIdentifier, validateIdentifier(ctx, k)); err != nil {
+			return err
 		}
-		if err = v.Validate(ctx); err != nil {
-			return
+		if err := validateSchemaRef(ctx, components.Schemas[k]); err != nil {
+			return err
 		}
 	}
 
-	for k, v := range components.MessageTraits {
-		if err = ValidateIdentifier(k); err != nil {
-			return
-		}
-		if err = v.Validate(ctx); err != nil {
-			return
-		}
+	validators := []func(ctx context.Context) error{
+		validateComponents(components.Messages, "messages"),
+		validateComponents(components.SecuritySchemes, "securitySchemes"),
+		validateComponents(components.Parameters, "parameters"),
+		validateComponents(components.CorrelationIds, "correlationIds"),
+		validateComponents(components.OperationTraits, "operationTraits"),
+		validateComponents(components.MessageTraits, "messageTraits"),
+		validateComponents(components.ServerBindings, "serverBindings"),
+		validateComponents(components.ChannelBindings, "channelBindings"),
+		validateComponents(components.OperationBindings, "operationBindings"),
+		validateComponents(components.MessageBindings, "messageBindings"),
 	}
 
-	for k, v := range components.ServerBindings {
-		if err = ValidateIdentifier(k); err != nil {
-			return
-		}
-		if err = v.Validate(ctx); err != nil {
-			return
+	for _, fn := range validators {
+		if err := fn(ctx); err != nil {
+			return err
 		}
 	}
 
-	for k, v := range components.ChannelBindings {
-		if err = ValidateIdentifier(k); err != nil {
-			return
-		}
-		if err = v.Validate(ctx); err != nil {
-			return
-		}
-	}
+	return nil
+}
 
-	for k, v := range components.OperationBindings {
-		if err = ValidateIdentifier(k); err != nil {
-			return
+// validateComponents returns a validator for keys and values of the components map.
+func validateComponents[V interface {
+	comparable
+	Validate(ctx context.Context) error
+}](m map[string]V, kind string) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		for _, k := range sortedKeys(m) {
+			ctx := validate.At(ctx, kind, k)
+			if err := validate.Report(ctx, validate.RuleIdentifier, validateIdentifier(ctx, k)); err != nil {
+				return err
+			}
+
+			if v := m[k]; validate.Visit(ctx, v) {
+				if err := v.Validate(ctx); err != nil {
+					return err
+				}
+			}
 		}
-		if err = v.Validate(ctx); err != nil {
-			return
-		}
-	}
 
-	for k, v := range components.MessageBindings {
-		if err = ValidateIdentifier(k); err != nil {
-			return
-		}
-		if err = v.Validate(ctx); err != nil {
-			return
-		}
+		return nil
 	}
-
-	return
 }
 
 const identifierPattern = `^[a-zA-Z0-9._-]+$`
 
 // IdentifierRegExp verifies whether Component object key matches 'identifierPattern' pattern, according to OpenAPI v3.x.0.
 // However, to be able supporting legacy OpenAPI v2.x, there is a need to customize above pattern in order not to fail
-// converted v2-v3 validation
+// converted v2-v3 validation. Prefer validate.WithIdentifierRegExp to set the pattern per validation.
 var IdentifierRegExp = regexp.MustCompile(identifierPattern)
 
 func ValidateIdentifier(value string) error {
-	if IdentifierRegExp.MatchString(value) {
+	return matchIdentifier(IdentifierRegExp, value)
+}
+
+// validateIdentifier validates the identifier with the pattern set in options of the context, if any.
+func validateIdentifier(ctx context.Context, value string) error {
+	if re := validate.GetOptions(ctx).IdentifierRegExp(); re != nil {
+		return matchIdentifier(re, value)
+	}
+
+	return ValidateIdentifier(value)
+}
+
+func matchIdentifier(re *regexp.Regexp, value string) error {
+	if re.MatchString(value) {
 		return nil
 	}
 
-	return fmt.Errorf("identifier %q is not supported by OpenAPIv3 standard (regexp: %q)", value, identifierPattern)
+	return fmt.Errorf(
+		"identifier %q is not supported by OpenAPIv3 standard (regexp: %q): %w",
+		value,
+		re.String(),
+		validate.ErrWrongField,
+	)
 }
@@ -49,10 +49,32 @@ func (value *CorrelationID) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
-func (value *CorrelationID) Validate(context.Context) error {
+func (value *CorrelationID) Validate(ctx context.Context) error {
+	if err := validate.Extensions(ctx, value.Extensions); err != nil {
+		return err
+	}
+
 	if value.Location == "" {
-		return fmt.Errorf("location field is required: %w", validate.ErrWrongField)
+		err := fmt.Errorf("location field is required: %w", validate.ErrWrongField)
+
+		return validate.Report(validate.At(ctx, "location"), validate.RuleRequired, err)
+	}
+
+	if _, err := ParseRuntimeExpression(value.Location); err != nil {
+		err = fmt.Errorf("%w: %w", err, validate.ErrWrongField)
+
+		return validate.Report(validate.At(ctx, "location"), validate.RuleValue, err)
 	}
 
 	return nil
 }
+
+// Evaluate returns the correlation ID of the message, the value its location points to.
+func (value *CorrelationID) Evaluate(message RuntimeMessage) (interface{}, error) {
+	expression, err := ParseRuntimeExpression(value.Location)
+	if err != nil {
+		return nil, err
+	}
+
+	return expression.Evaluate(message)
+}
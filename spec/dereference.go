@@ -0,0 +1,702 @@
+package spec
+
+import (
+	"fmt"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// CircularRefMode tells Dereference what to do with refs that make schemas recursive.
+type CircularRefMode int
+
+const (
+	// KeepCircularRefs keeps refs of recursive schemas as they are, their values still point to the enclosing copies.
+	KeepCircularRefs CircularRefMode = iota
+	// FailOnCircularRefs makes Dereference return CircularRefError when a schema is recursive.
+	FailOnCircularRefs
+	// ShareCircularRefs clears refs of recursive schemas too, their values point to the enclosing copies.
+	// Such documents can be walked in memory, but can not be marshalled.
+	ShareCircularRefs
+)
+
+// DereferenceOption configures Dereference.
+type DereferenceOption func(d *dereferencer)
+
+// WithCircularRefs sets how refs of recursive schemas are handled, KeepCircularRefs is the default.
+func WithCircularRefs(mode CircularRefMode) DereferenceOption {
+	return func(d *dereferencer) {
+		d.circularRefs = mode
+	}
+}
+
+// Dereference returns a deep copy of the document with values of all refs inlined and refs cleared.
+//
+// Refs must be resolved before, see Loader. Values shared by several refs stay shared in the copy.
+// Kept refs of recursive schemas are not rewritten, call InternalizeRefs before
+// when they may point to other documents.
+func (doc *T) Dereference(opts ...DereferenceOption) (*T, error) {
+	d := &dereferencer{
+		copies: make(map[interface{}]interface{}),
+	}
+
+	for _, opt := range opts {
+		opt(d)
+	}
+
+	return d.document(doc)
+}
+
+type dereferencer struct {
+	circularRefs CircularRefMode
+
+	// copies maps values of the document to their copies.
+	copies map[interface{}]interface{}
+	// schemas holds schemas which copies are being filled, outermost first.
+	schemas []schemaStep
+}
+
+type schemaStep struct {
+	ref   string
+	value *openapi3.Schema
+}
+
+func (d *dereferencer) document(doc *T) (*T, error) {
+	c := *doc
+	c.Extensions = copyExtensions(doc.Extensions)
+
+	var err error
+
+	if c.Info, err = cloneJSON(doc.Info); err != nil {
+		return nil, err
+	}
+
+	if doc.Servers != nil {
+		c.Servers = make(Servers, len(doc.Servers))
+
+		for _, k := range sortedKeys(doc.Servers) {
+			if c.Servers[k], err = d.server(doc.Servers[k]); err != nil {
+				return nil, fmt.Errorf("server %q: %w", k, err)
+			}
+		}
+	}
+
+	if c.Tags, err = cloneJSON(doc.Tags); err != nil {
+		return nil, err
+	}
+
+	if c.ExternalDocs, err = cloneJSON(doc.ExternalDocs); err != nil {
+		return nil, err
+	}
+
+	if doc.Components != nil {
+		if c.Components, err = d.components(doc.Components); err != nil {
+			return nil, err
+		}
+	}
+
+	if doc.Channels != nil {
+		c.Channels = make(Channels, len(doc.Channels))
+
+		for _, k := range sortedKeys(doc.Channels) {
+			if c.Channels[k], err = d.channel(doc.Channels[k]); err != nil {
+				return nil, fmt.Errorf("channel %q: %w", k, err)
+			}
+		}
+	}
+
+	return &c, nil
+}
+
+func (d *dereferencer) components(components *Components) (*Components, error) {
+	c := *components
+	c.Extensions = copyExtensions(components.Extensions)
+
+	var err error
+
+	if components.Schemas != nil {
+		c.Schemas = make(openapi3.Schemas, len(components.Schemas))
+
+		for _, k := range sortedKeys(components.Schemas) {
+			if c.Schemas[k], err = d.schemaRef(components.Schemas[k]); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if c.Messages, err = copyComponents(d, components.Messages, d.message); err != nil {
+		return nil, err
+	}
+
+	if c.SecuritySchemes, err = cloneJSON(components.SecuritySchemes); err != nil {
+		return nil, err
+	}
+
+	if c.Parameters, err = copyComponents(d, components.Parameters, d.parameter); err != nil {
+		return nil, err
+	}
+
+	if c.CorrelationIds, err = copyComponents(d, components.CorrelationIds, d.correlationID); err != nil {
+		return nil, err
+	}
+
+	if c.OperationTraits, err = copyComponents(d, components.OperationTraits, d.operationTrait); err != nil {
+		return nil, err
+	}
+
+	if c.MessageTraits, err = copyComponents(d, components.MessageTraits, d.messageTrait); err != nil {
+		return nil, err
+	}
+
+	if c.ServerBindings, err = copyComponents(d, components.ServerBindings, d.serverBindings); err != nil {
+		return nil, err
+	}
+
+	if c.ChannelBindings, err = copyComponents(d, components.ChannelBindings, d.channelBindings); err != nil {
+		return nil, err
+	}
+
+	if c.OperationBindings, err = copyComponents(d, components.OperationBindings, d.operationBindings); err != nil {
+		return nil, err
+	}
+
+	if c.MessageBindings, err = copyComponents(d, components.MessageBindings, d.messageBindings); err != nil {
+		return nil, err
+	}
+
+	return &c, nil
+}
+
+// server copies the server through its JSON form and then copies schemas its bindings hold.
+func (d *dereferencer) server(server *Server) (*Server, error) {
+	c, err := cloneJSON(server)
+	if err != nil || c == nil {
+		return c, err
+	}
+
+	if c.Bindings, err = d.serverBindings(server.Bindings); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func (d *dereferencer) channel(channel *Channel) (*Channel, error) {
+	if channel == nil {
+		return nil, nil
+	}
+
+	c := *channel
+	c.Extensions = copyExtensions(channel.Extensions)
+
+	var err error
+
+	if c.Subscribe, err = dereferenceRefG(d, channel.Subscribe, d.operation); err != nil {
+		return nil, err
+	}
+
+	if c.Publish, err = dereferenceRefG(d, channel.Publish, d.operation); err != nil {
+		return nil, err
+	}
+
+	if channel.Parameters != nil {
+		c.Parameters = make(ParametersRefs, len(channel.Parameters))
+
+		for _, k := range sortedKeys(channel.Parameters) {
+			if c.Parameters[k], err = dereferenceRefG(d, channel.Parameters[k], d.parameter); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if c.Bindings, err = dereferenceRefG(d, channel.Bindings, d.channelBindings); err != nil {
+		return nil, err
+	}
+
+	return &c, nil
+}
+
+func (d *dereferencer) operation(operation *Operation) (*Operation, error) {
+	trait, err := d.operationTrait(&operation.OperationTrait)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &Operation{OperationTrait: *trait}
+
+	if operation.Traits != nil {
+		c.Traits = make([]*OperationTraitRef, len(operation.Traits))
+
+		for i, v := range operation.Traits {
+			if c.Traits[i], err = dereferenceRefG(d, v, d.operationTrait); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if v := operation.Message; v != nil {
+		c.Message = &MessageOneOf{}
+
+		if v.Ref != "" || v.Value != nil {
+			message, err := dereferenceRefG(d, &v.MessageRef, d.message)
+			if err != nil {
+				return nil, err
+			}
+
+			c.Message.MessageRef = *message
+		}
+
+		if v.OneOf != nil {
+			c.Message.OneOf = make([]*MessageRef, len(v.OneOf))
+
+			for i, ent := range v.OneOf {
+				if c.Message.OneOf[i], err = dereferenceRefG(d, ent, d.message); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	return c, nil
+}
+
+func (d *dereferencer) operationTrait(trait *OperationTrait) (*OperationTrait, error) {
+	c := *trait
+	c.Extensions = copyExtensions(trait.Extensions)
+
+	var err error
+
+	if c.Tags, err = cloneJSON(trait.Tags); err != nil {
+		return nil, err
+	}
+
+	if c.ExternalDocs, err = cloneJSON(trait.ExternalDocs); err != nil {
+		return nil, err
+	}
+
+	if c.Bindings, err = d.operationBindings(trait.Bindings); err != nil {
+		return nil, err
+	}
+
+	return &c, nil
+}
+
+func (d *dereferencer) message(message *Message) (*Message, error) {
+	trait, err := d.messageTrait(&message.MessageTrait)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &Message{MessageTrait: *trait}
+
+	if c.Payload, err = d.schemaRef(message.Payload); err != nil {
+		return nil, err
+	}
+
+	if message.Traits != nil {
+		c.Traits = make([]*MessageTraitRef, len(message.Traits))
+
+		for i, v := range message.Traits {
+			if c.Traits[i], err = dereferenceRefG(d, v, d.messageTrait); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return c, nil
+}
+
+func (d *dereferencer) messageTrait(trait *MessageTrait) (*MessageTrait, error) {
+	c := *trait
+	c.Extensions = copyExtensions(trait.Extensions)
+
+	var err error
+
+	if c.Headers, err = d.schemaRef(trait.Headers); err != nil {
+		return nil, err
+	}
+
+	if c.CorrelationID, err = dereferenceRefG(d, trait.CorrelationID, d.correlationID); err != nil {
+		return nil, err
+	}
+
+	if c.Tags, err = cloneJSON(trait.Tags); err != nil {
+		return nil, err
+	}
+
+	if c.ExternalDocs, err = cloneJSON(trait.ExternalDocs); err != nil {
+		return nil, err
+	}
+
+	if c.Bindings, err = d.messageBindings(trait.Bindings); err != nil {
+		return nil, err
+	}
+
+	if trait.Examples != nil {
+		c.Examples = make([]map[string]interface{}, len(trait.Examples))
+
+		for i, v := range trait.Examples {
+			c.Examples[i] = copyExtensions(v)
+		}
+	}
+
+	return &c, nil
+}
+
+func (d *dereferencer) parameter(parameter *Parameter) (*Parameter, error) {
+	c := *parameter
+	c.Extensions = copyExtensions(parameter.Extensions)
+
+	var err error
+
+	if c.Schema, err = d.schema("", parameter.Schema); err != nil {
+		return nil, err
+	}
+
+	return &c, nil
+}
+
+func (d *dereferencer) correlationID(correlationID *CorrelationID) (*CorrelationID, error) {
+	c := *correlationID
+	c.Extensions = copyExtensions(correlationID.Extensions)
+
+	return &c, nil
+}
+
+// serverBindings copies bindings through their JSON form and then copies schemas they hold.
+func (d *dereferencer) serverBindings(value *ServerBindings) (*ServerBindings, error) {
+	c, err := cloneJSON(value)
+	if err != nil || c == nil {
+		return c, err
+	}
+
+	if v := value.Mqtt; v != nil {
+		if v.SessionExpiryInterval != nil {
+			if c.Mqtt.SessionExpiryInterval.Schema, err = d.schema("", v.SessionExpiryInterval.Schema); err != nil {
+				return nil, err
+			}
+		}
+
+		if v.MaximumPacketSize != nil {
+			if c.Mqtt.MaximumPacketSize.Schema, err = d.schema("", v.MaximumPacketSize.Schema); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return c, nil
+}
+
+func (d *dereferencer) channelBindings(value *ChannelBindings) (*ChannelBindings, error) {
+	c, err := cloneJSON(value)
+	if err != nil || c == nil {
+		return c, err
+	}
+
+	if v := value.Ws; v != nil {
+		if c.Ws.Query, err = d.schema("", v.Query); err != nil {
+			return nil, err
+		}
+
+		if c.Ws.Headers, err = d.schema("", v.Headers); err != nil {
+			return nil, err
+		}
+	}
+
+	return c, nil
+}
+
+func (d *dereferencer) operationBindings(value *OperationBindings) (*OperationBindings, error) {
+	c, err := cloneJSON(value)
+	if err != nil || c == nil {
+		return c, err
+	}
+
+	if v := value.Http; v != nil {
+		if c.Http.Query, err = d.schema("", v.Query); err != nil {
+			return nil, err
+		}
+	}
+
+	if v := value.Kafka; v != nil {
+		if c.Kafka.GroupID, err = d.schema("", v.GroupID); err != nil {
+			return nil, err
+		}
+
+		if c.Kafka.ClientID, err = d.schema("", v.ClientID); err != nil {
+			return nil, err
+		}
+	}
+
+	if v := value.Mqtt; v != nil && v.MessageExpiryInterval != nil {
+		if c.Mqtt.MessageExpiryInterval.Schema, err = d.schema("", v.MessageExpiryInterval.Schema); err != nil {
+			return nil, err
+		}
+	}
+
+	return c, nil
+}
+
+func (d *dereferencer) messageBindings(value *MessageBindings) (*MessageBindings, error) {
+	c, err := cloneJSON(value)
+	if err != nil || c == nil {
+		return c, err
+	}
+
+	if v := value.Http; v != nil {
+		if c.Http.Headers, err = d.schema("", v.Headers); err != nil {
+			return nil, err
+		}
+	}
+
+	if v := value.Kafka; v != nil {
+		if c.Kafka.Key, err = d.schema("", v.Key); err != nil {
+			return nil, err
+		}
+	}
+
+	if v := value.Jms; v != nil {
+		if c.Jms.Headers, err = d.schema("", v.Headers); err != nil {
+			return nil, err
+		}
+	}
+
+	if v := value.Mqtt; v != nil {
+		if c.Mqtt.CorrelationData, err = d.schema("", v.CorrelationData); err != nil {
+			return nil, err
+		}
+
+		if v.ResponseTopic != nil {
+			if c.Mqtt.ResponseTopic.Schema, err = d.schema("", v.ResponseTopic.Schema); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return c, nil
+}
+
+func (d *dereferencer) schemaRef(component *openapi3.SchemaRef) (*openapi3.SchemaRef, error) {
+	if component == nil {
+		return nil, nil
+	}
+
+	ref, value := component.Ref, component.Value
+	if value == nil {
+		return nil, foundUnresolvedRef(ref)
+	}
+
+	if i := d.enclosingSchema(value); i >= 0 {
+		switch d.circularRefs {
+		case FailOnCircularRefs:
+			return nil, d.circularRefError(i, ref)
+		case KeepCircularRefs:
+			if ref != "" {
+				return &openapi3.SchemaRef{Ref: ref, Value: d.copies[value].(*openapi3.Schema)}, nil
+			}
+		}
+	}
+
+	c, err := d.schema(ref, value)
+	if err != nil {
+		return nil, err
+	}
+
+	return &openapi3.SchemaRef{Extensions: copyExtensions(component.Extensions), Value: c}, nil
+}
+
+func (d *dereferencer) schemaRefs(refs openapi3.SchemaRefs) (openapi3.SchemaRefs, error) {
+	if refs == nil {
+		return nil, nil
+	}
+
+	c := make(openapi3.SchemaRefs, len(refs))
+
+	for i, v := range refs {
+		var err error
+		if c[i], err = d.schemaRef(v); err != nil {
+			return nil, err
+		}
+	}
+
+	return c, nil
+}
+
+// schema copies the schema found by the ref. The copy is registered before its subschemas are copied,
+// so recursive subschemas point to it.
+func (d *dereferencer) schema(ref string, schema *openapi3.Schema) (*openapi3.Schema, error) {
+	if schema == nil {
+		return nil, nil
+	}
+
+	if c, ok := d.copies[schema]; ok {
+		return c.(*openapi3.Schema), nil
+	}
+
+	c := *schema
+	d.copies[schema] = &c
+
+	d.schemas = append(d.schemas, schemaStep{ref: ref, value: schema})
+	defer func() { d.schemas = d.schemas[:len(d.schemas)-1] }()
+
+	c.Extensions = copyExtensions(schema.Extensions)
+	c.Default = copyJSONValue(schema.Default)
+	c.Example = copyJSONValue(schema.Example)
+	c.Min = copyPointer(schema.Min)
+	c.Max = copyPointer(schema.Max)
+	c.MultipleOf = copyPointer(schema.MultipleOf)
+	c.MaxLength = copyPointer(schema.MaxLength)
+	c.MaxItems = copyPointer(schema.MaxItems)
+	c.MaxProps = copyPointer(schema.MaxProps)
+	c.AdditionalProperties.Has = copyPointer(schema.AdditionalProperties.Has)
+
+	if schema.Type != nil {
+		types := append(openapi3.Types(nil), *schema.Type...)
+		c.Type = &types
+	}
+
+	if schema.Enum != nil {
+		c.Enum = make([]interface{}, len(schema.Enum))
+		for i, v := range schema.Enum {
+			c.Enum[i] = copyJSONValue(v)
+		}
+	}
+
+	if schema.Required != nil {
+		c.Required = append([]string(nil), schema.Required...)
+	}
+
+	var err error
+
+	if c.ExternalDocs, err = cloneJSON(schema.ExternalDocs); err != nil {
+		return nil, err
+	}
+
+	if c.XML, err = cloneJSON(schema.XML); err != nil {
+		return nil, err
+	}
+
+	if c.Discriminator, err = cloneJSON(schema.Discriminator); err != nil {
+		return nil, err
+	}
+
+	if c.OneOf, err = d.schemaRefs(schema.OneOf); err != nil {
+		return nil, err
+	}
+
+	if c.AnyOf, err = d.schemaRefs(schema.AnyOf); err != nil {
+		return nil, err
+	}
+
+	if c.AllOf, err = d.schemaRefs(schema.AllOf); err != nil {
+		return nil, err
+	}
+
+	if c.Not, err = d.schemaRef(schema.Not); err != nil {
+		return nil, err
+	}
+
+	if c.Items, err = d.schemaRef(schema.Items); err != nil {
+		return nil, err
+	}
+
+	if c.AdditionalProperties.Schema, err = d.schemaRef(schema.AdditionalProperties.Schema); err != nil {
+		return nil, err
+	}
+
+	if schema.Properties != nil {
+		c.Properties = make(openapi3.Schemas, len(schema.Properties))
+
+		for _, k := range sortedKeys(schema.Properties) {
+			if c.Properties[k], err = d.schemaRef(schema.Properties[k]); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return &c, nil
+}
+
+// enclosingSchema returns the index of the schema in the stack of schemas being copied, or -1.
+func (d *dereferencer) enclosingSchema(schema *openapi3.Schema) int {
+	for i, v := range d.schemas {
+		if v.value == schema {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func (d *dereferencer) circularRefError(from int, ref string) error {
+	var chain []string
+
+	for _, v := range d.schemas[from:] {
+		if v.ref != "" {
+			chain = append(chain, v.ref)
+		}
+	}
+
+	return &CircularRefError{Chain: append(chain, ref)}
+}
+
+// dereferenceRefG returns a component holding a copy of the value of the ref.
+func dereferenceRefG[V refValue](d *dereferencer, component *RefG[V], copyValue func(V) (V, error)) (*RefG[V], error) {
+	if component == nil {
+		return nil, nil
+	}
+
+	var zero V
+
+	if component.Value == zero {
+		return nil, foundUnresolvedRef(component.Ref)
+	}
+
+	value, err := dereferenceValue(d, component.Value, copyValue)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RefG[V]{Value: value}, nil
+}
+
+// dereferenceValue copies the value once, later calls return the same copy.
+func dereferenceValue[V comparable](d *dereferencer, value V, copyValue func(V) (V, error)) (V, error) {
+	if c, ok := d.copies[value]; ok {
+		return c.(V), nil
+	}
+
+	c, err := copyValue(value)
+	if err != nil {
+		return c, err
+	}
+
+	d.copies[value] = c
+
+	return c, nil
+}
+
+func copyComponents[V comparable, M ~map[string]V](d *dereferencer, m M, copyValue func(V) (V, error)) (M, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	var zero V
+
+	c := make(M, len(m))
+
+	for _, k := range sortedKeys(m) {
+		v := m[k]
+		if v == zero {
+			c[k] = v
+
+			continue
+		}
+
+		var err error
+		if c[k], err = dereferenceValue(d, v, copyValue); err != nil {
+			return nil, err
+		}
+	}
+
+	return c, nil
+}
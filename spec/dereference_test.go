@@ -0,0 +1,119 @@
+package spec
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+func TestT_Dereference(t *testing.T) {
+	data, err := os.ReadFile("./test/loader/internal.yml")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	doc, err := NewLoader().LoadFromData(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c, err := doc.Dereference()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := c.Validate(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	out, err := json.Marshal(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if strings.Contains(string(out), `"$ref"`) {
+		t.Fatalf("dereferenced document has refs: %s", out)
+	}
+
+	const measured = "smartylighting/streetlights/1/0/event/{streetlightId}/lighting/measured"
+
+	message := c.Channels[measured].Subscribe.Value.Message
+	if message.Ref != "" || message.Value == doc.Components.Messages["lightMeasured"] {
+		t.Fatal("message is not copied")
+	}
+
+	if message.Value != c.Components.Messages["lightMeasured"] {
+		t.Fatal("message copy is not shared with the component copy")
+	}
+
+	if message.Value.Payload.Value.Properties["sentAt"].Value != c.Components.Schemas["sentAt"].Value {
+		t.Fatal("schema copy is not shared with the component copy")
+	}
+
+	if doc.Channels[measured].Subscribe.Value.Message.Ref == "" {
+		t.Fatal("original document is changed")
+	}
+}
+
+func TestT_Dereference_RecursiveSchema(t *testing.T) {
+	doc, err := NewLoader().LoadFromData([]byte(`
+asyncapi: 2.0.0
+info:
+  title: Tree API
+  version: 1.0.0
+channels:
+  trees:
+    subscribe:
+      message:
+        payload:
+          $ref: '#/components/schemas/Node'
+components:
+  schemas:
+    Node:
+      type: object
+      properties:
+        children:
+          type: array
+          items:
+            $ref: '#/components/schemas/Node'
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	children := func(c *T) *openapi3.Schema {
+		return c.Channels["trees"].Subscribe.Value.Message.Value.Payload.Value.Properties["children"].Value
+	}
+
+	c, err := doc.Dereference(WithCircularRefs(ShareCircularRefs))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if items := children(c).Items; items.Ref != "" || items.Value != c.Components.Schemas["Node"].Value {
+		t.Fatal("recursive schema is not shared")
+	}
+
+	c, err = doc.Dereference()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if items := children(c).Items; items.Ref != "#/components/schemas/Node" {
+		t.Fatalf("ref of recursive schema is not kept, got %q", items.Ref)
+	}
+
+	if _, err := json.Marshal(c); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = doc.Dereference(WithCircularRefs(FailOnCircularRefs))
+	if !errors.Is(err, ErrCircularRef) {
+		t.Fatalf("expected ErrCircularRef, got %v", err)
+	}
+}
@@ -0,0 +1,92 @@
+package spec
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+
+	"github.com/rdmrcv/go-asyncapi2/spec/validate"
+)
+
+// sortedKeys returns keys of the map in the sorted order, so walking documents is deterministic.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+// escapeJSONPointerToken escapes the token to be used in JSON pointers.
+func escapeJSONPointerToken(token string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1")
+}
+
+// cloneJSON copies values that hold no refs through their JSON form.
+func cloneJSON[V any](value V) (V, error) {
+	var c V
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return c, err
+	}
+
+	err = json.Unmarshal(data, &c)
+
+	return c, err
+}
+
+// copyJSONValue deeply copies maps and slices of values decoded from JSON.
+func copyJSONValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		return copyExtensions(v)
+	case []interface{}:
+		c := make([]interface{}, len(v))
+		for i, ent := range v {
+			c[i] = copyJSONValue(ent)
+		}
+
+		return c
+	default:
+		return value
+	}
+}
+
+func copyExtensions(m map[string]interface{}) map[string]interface{} {
+	if m == nil {
+		return nil
+	}
+
+	c := make(map[string]interface{}, len(m))
+	for k, v := range m {
+		c[k] = copyJSONValue(v)
+	}
+
+	return c
+}
+
+func copyPointer[V any](value *V) *V {
+	if value == nil {
+		return nil
+	}
+
+	c := *value
+
+	return &c
+}
+
+// validateSchemaRef validates the value of the schema ref, the ref must be resolved.
+func validateSchemaRef(ctx context.Context, component *openapi3.SchemaRef) error {
+	if component.Value == nil {
+		return validate.Report(ctx, validate.RuleRef, foundUnresolvedRef(component.Ref))
+	}
+
+	return validate.Schema(ctx, component.Value)
+}
@@ -0,0 +1,589 @@
+package spec
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// RefNameResolver maps an external ref to the name of the component its value is moved to.
+//
+// InternalizeRefs makes names unique, so the resolver may return the same name for different refs.
+type RefNameResolver func(ref string) string
+
+var invalidIdentifierCharsRegExp = regexp.MustCompile(`[^a-zA-Z0-9._-]+`)
+
+// DefaultRefNameResolver names the component after the last token of the ref fragment,
+// or after the referenced file when the ref has no fragment.
+//
+// Characters not allowed in component names are replaced with underscores.
+func DefaultRefNameResolver(ref string) string {
+	file, fragment, _ := strings.Cut(ref, "#")
+
+	var name string
+	if tokens := parseJSONPointer(fragment); len(tokens) != 0 {
+		name = tokens[len(tokens)-1]
+	} else {
+		name = path.Base(file)
+		for ext := path.Ext(name); ext != "" && ext != name; ext = path.Ext(name) {
+			name = strings.TrimSuffix(name, ext)
+		}
+	}
+
+	return invalidIdentifierCharsRegExp.ReplaceAllString(name, "_")
+}
+
+// InternalizeRefs moves values of external refs into the components of the document and points the refs there,
+// so the document no longer depends on other files.
+//
+// Refs must be resolved before, see Loader. Values found by the same ref share one component.
+// Operations have no place in the components object, so their external refs are replaced by values.
+func (doc *T) InternalizeRefs(refNameResolver RefNameResolver) error {
+	if refNameResolver == nil {
+		refNameResolver = DefaultRefNameResolver
+	}
+
+	in := &refInternalizer{
+		doc:      doc,
+		resolver: refNameResolver,
+		names:    make(map[interface{}]string),
+		visited:  make(map[interface{}]struct{}),
+	}
+
+	return in.internalize()
+}
+
+type refInternalizer struct {
+	doc      *T
+	resolver RefNameResolver
+
+	// names maps values held by the components object to refs pointing to them.
+	names   map[interface{}]string
+	visited map[interface{}]struct{}
+}
+
+func (in *refInternalizer) internalize() error {
+	components := in.doc.Components
+
+	if components != nil {
+		for _, k := range sortedKeys(components.Schemas) {
+			if v := components.Schemas[k]; v != nil && v.Value != nil && v.Ref == "" {
+				in.names[v.Value] = componentRef("schemas", k)
+			}
+		}
+
+		nameComponents(in, "messages", components.Messages)
+		nameComponents(in, "parameters", components.Parameters)
+		nameComponents(in, "correlationIds", components.CorrelationIds)
+		nameComponents(in, "operationTraits", components.OperationTraits)
+		nameComponents(in, "messageTraits", components.MessageTraits)
+		nameComponents(in, "serverBindings", components.ServerBindings)
+		nameComponents(in, "channelBindings", components.ChannelBindings)
+		nameComponents(in, "operationBindings", components.OperationBindings)
+		nameComponents(in, "messageBindings", components.MessageBindings)
+
+		// Walk a snapshot of components, values moved there are walked as they are added.
+		if err := in.components(*components); err != nil {
+			return err
+		}
+	}
+
+	for _, k := range sortedKeys(in.doc.Servers) {
+		if v := in.doc.Servers[k]; v != nil {
+			if err := in.serverBindings(v.Bindings, false); err != nil {
+				return fmt.Errorf("server %q: %w", k, err)
+			}
+		}
+	}
+
+	for _, k := range sortedKeys(in.doc.Channels) {
+		if err := in.channel(in.doc.Channels[k], false); err != nil {
+			return fmt.Errorf("channel %q: %w", k, err)
+		}
+	}
+
+	return nil
+}
+
+func (in *refInternalizer) components(components Components) error {
+	for _, k := range sortedKeys(components.Schemas) {
+		v := components.Schemas[k]
+		if v == nil {
+			continue
+		}
+
+		// A component that is an external ref becomes the value itself.
+		if !isInternalRef(v.Ref, false) {
+			if v.Value == nil {
+				return foundUnresolvedRef(v.Ref)
+			}
+
+			v.Ref = ""
+			in.names[v.Value] = componentRef("schemas", k)
+
+			if err := in.schema(v.Value, true); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		if err := in.schemaRef(v, false); err != nil {
+			return err
+		}
+	}
+
+	for _, k := range sortedKeys(components.Messages) {
+		if err := in.message(components.Messages[k], false); err != nil {
+			return err
+		}
+	}
+
+	for _, k := range sortedKeys(components.Parameters) {
+		if err := in.parameter(components.Parameters[k], false); err != nil {
+			return err
+		}
+	}
+
+	for _, k := range sortedKeys(components.OperationTraits) {
+		if err := in.operationTrait(components.OperationTraits[k], false); err != nil {
+			return err
+		}
+	}
+
+	for _, k := range sortedKeys(components.MessageTraits) {
+		if err := in.messageTrait(components.MessageTraits[k], false); err != nil {
+			return err
+		}
+	}
+
+	for _, k := range sortedKeys(components.ServerBindings) {
+		if err := in.serverBindings(components.ServerBindings[k], false); err != nil {
+			return err
+		}
+	}
+
+	for _, k := range sortedKeys(components.ChannelBindings) {
+		if err := in.channelBindings(components.ChannelBindings[k], false); err != nil {
+			return err
+		}
+	}
+
+	for _, k := range sortedKeys(components.OperationBindings) {
+		if err := in.operationBindings(components.OperationBindings[k], false); err != nil {
+			return err
+		}
+	}
+
+	for _, k := range sortedKeys(components.MessageBindings) {
+		if err := in.messageBindings(components.MessageBindings[k], false); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (in *refInternalizer) channel(channel *Channel, external bool) error {
+	if channel == nil {
+		return nil
+	}
+
+	if err := inlineRefG(in, channel.Subscribe, external, in.operation); err != nil {
+		return err
+	}
+
+	if err := inlineRefG(in, channel.Publish, external, in.operation); err != nil {
+		return err
+	}
+
+	for _, k := range sortedKeys(channel.Parameters) {
+		if err := internalizeRefG(in, channel.Parameters[k], external, "parameters", parametersOf, in.parameter); err != nil {
+			return err
+		}
+	}
+
+	return internalizeRefG(in, channel.Bindings, external, "channelBindings", channelBindingsOf, in.channelBindings)
+}
+
+func (in *refInternalizer) operation(operation *Operation, external bool) error {
+	for _, v := range operation.Traits {
+		if err := internalizeRefG(in, v, external, "operationTraits", operationTraitsOf, in.operationTrait); err != nil {
+			return err
+		}
+	}
+
+	if v := operation.Message; v != nil {
+		if err := internalizeRefG(in, &v.MessageRef, external, "messages", messagesOf, in.message); err != nil {
+			return err
+		}
+
+		for _, ent := range v.OneOf {
+			if err := internalizeRefG(in, ent, external, "messages", messagesOf, in.message); err != nil {
+				return err
+			}
+		}
+	}
+
+	return in.operationTrait(&operation.OperationTrait, external)
+}
+
+func (in *refInternalizer) operationTrait(trait *OperationTrait, external bool) error {
+	return in.operationBindings(trait.Bindings, external)
+}
+
+func (in *refInternalizer) message(message *Message, external bool) error {
+	if err := in.schemaRef(message.Payload, external); err != nil {
+		return err
+	}
+
+	for _, v := range message.Traits {
+		if err := internalizeRefG(in, v, external, "messageTraits", messageTraitsOf, in.messageTrait); err != nil {
+			return err
+		}
+	}
+
+	return in.messageTrait(&message.MessageTrait, external)
+}
+
+func (in *refInternalizer) messageTrait(trait *MessageTrait, external bool) error {
+	if err := in.schemaRef(trait.Headers, external); err != nil {
+		return err
+	}
+
+	noop := func(*CorrelationID, bool) error { return nil }
+	if err := internalizeRefG(in, trait.CorrelationID, external, "correlationIds", correlationIDsOf, noop); err != nil {
+		return err
+	}
+
+	return in.messageBindings(trait.Bindings, external)
+}
+
+func (in *refInternalizer) parameter(parameter *Parameter, external bool) error {
+	return in.schema(parameter.Schema, external)
+}
+
+func (in *refInternalizer) serverBindings(value *ServerBindings, external bool) error {
+	if value == nil {
+		return nil
+	}
+
+	if v := value.Mqtt; v != nil {
+		if v.SessionExpiryInterval != nil {
+			if err := in.schema(v.SessionExpiryInterval.Schema, external); err != nil {
+				return err
+			}
+		}
+
+		if v.MaximumPacketSize != nil {
+			if err := in.schema(v.MaximumPacketSize.Schema, external); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (in *refInternalizer) channelBindings(value *ChannelBindings, external bool) error {
+	if value == nil {
+		return nil
+	}
+
+	if v := value.Ws; v != nil {
+		if err := in.schema(v.Query, external); err != nil {
+			return err
+		}
+
+		if err := in.schema(v.Headers, external); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (in *refInternalizer) operationBindings(value *OperationBindings, external bool) error {
+	if value == nil {
+		return nil
+	}
+
+	if v := value.Http; v != nil {
+		if err := in.schema(v.Query, external); err != nil {
+			return err
+		}
+	}
+
+	if v := value.Kafka; v != nil {
+		if err := in.schema(v.GroupID, external); err != nil {
+			return err
+		}
+
+		if err := in.schema(v.ClientID, external); err != nil {
+			return err
+		}
+	}
+
+	if v := value.Mqtt; v != nil && v.MessageExpiryInterval != nil {
+		if err := in.schema(v.MessageExpiryInterval.Schema, external); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (in *refInternalizer) messageBindings(value *MessageBindings, external bool) error {
+	if value == nil {
+		return nil
+	}
+
+	if v := value.Http; v != nil {
+		if err := in.schema(v.Headers, external); err != nil {
+			return err
+		}
+	}
+
+	if v := value.Kafka; v != nil {
+		if err := in.schema(v.Key, external); err != nil {
+			return err
+		}
+	}
+
+	if v := value.Jms; v != nil {
+		if err := in.schema(v.Headers, external); err != nil {
+			return err
+		}
+	}
+
+	if v := value.Mqtt; v != nil {
+		if err := in.schema(v.CorrelationData, external); err != nil {
+			return err
+		}
+
+		if v.ResponseTopic != nil {
+			if err := in.schema(v.ResponseTopic.Schema, external); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (in *refInternalizer) schemaRef(component *openapi3.SchemaRef, external bool) error {
+	if component == nil {
+		return nil
+	}
+
+	ref, value := component.Ref, component.Value
+
+	if ref == "" {
+		return in.schema(value, external)
+	}
+
+	if name, ok := in.names[value]; ok && value != nil {
+		component.Ref = name
+
+		return nil
+	}
+
+	if isInternalRef(ref, external) {
+		return in.schema(value, external)
+	}
+
+	if value == nil {
+		return foundUnresolvedRef(ref)
+	}
+
+	component.Ref = addComponent(in, "schemas", ref, schemasOf, &openapi3.SchemaRef{Value: value}, value)
+
+	return in.schema(value, true)
+}
+
+func (in *refInternalizer) schema(schema *openapi3.Schema, external bool) error {
+	if schema == nil || !in.visit(schema) {
+		return nil
+	}
+
+	for _, refs := range []openapi3.SchemaRefs{schema.OneOf, schema.AnyOf, schema.AllOf} {
+		for _, v := range refs {
+			if err := in.schemaRef(v, external); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, k := range sortedKeys(schema.Properties) {
+		if err := in.schemaRef(schema.Properties[k], external); err != nil {
+			return err
+		}
+	}
+
+	for _, v := range []*openapi3.SchemaRef{schema.Not, schema.Items, schema.AdditionalProperties.Schema} {
+		if err := in.schemaRef(v, external); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// visit reports whether the value is met for the first time.
+func (in *refInternalizer) visit(value interface{}) bool {
+	if _, ok := in.visited[value]; ok {
+		return false
+	}
+
+	in.visited[value] = struct{}{}
+
+	return true
+}
+
+// internalizeRefG moves the value of the external ref into the components entries returned by the entries func.
+func internalizeRefG[V refValue, M ~map[string]V](
+	in *refInternalizer,
+	component *RefG[V],
+	external bool,
+	kind string,
+	entries func(*Components) *M,
+	walk func(V, bool) error,
+) error {
+	if component == nil {
+		return nil
+	}
+
+	var zero V
+
+	ref, value := component.Ref, component.Value
+
+	if ref == "" {
+		if value != zero && in.visit(value) {
+			return walk(value, external)
+		}
+
+		return nil
+	}
+
+	if name, ok := in.names[value]; ok && value != zero {
+		component.Ref = name
+
+		return nil
+	}
+
+	if isInternalRef(ref, external) {
+		if value != zero && in.visit(value) {
+			return walk(value, external)
+		}
+
+		return nil
+	}
+
+	if value == zero {
+		return foundUnresolvedRef(ref)
+	}
+
+	component.Ref = addComponent(in, kind, ref, entries, value, value)
+
+	if in.visit(value) {
+		return walk(value, true)
+	}
+
+	return nil
+}
+
+// inlineRefG replaces the external ref by its value.
+func inlineRefG[V refValue](in *refInternalizer, component *RefG[V], external bool, walk func(V, bool) error) error {
+	if component == nil {
+		return nil
+	}
+
+	var zero V
+
+	ref, value := component.Ref, component.Value
+
+	if ref != "" && !isInternalRef(ref, external) {
+		if value == zero {
+			return foundUnresolvedRef(ref)
+		}
+
+		component.Ref = ""
+		external = true
+	}
+
+	if value != zero && in.visit(value) {
+		return walk(value, external)
+	}
+
+	return nil
+}
+
+// addComponent puts the entry under a unique name into the components map and returns a ref to it.
+func addComponent[V any, M ~map[string]V](
+	in *refInternalizer,
+	kind, ref string,
+	entries func(*Components) *M,
+	entry V,
+	value interface{},
+) string {
+	if in.doc.Components == nil {
+		in.doc.Components = &Components{}
+	}
+
+	m := entries(in.doc.Components)
+	if *m == nil {
+		*m = make(M)
+	}
+
+	base := in.resolver(ref)
+	if base == "" {
+		base = kind
+	}
+
+	name := base
+	for i := 2; ; i++ {
+		if _, ok := (*m)[name]; !ok {
+			break
+		}
+
+		name = fmt.Sprintf("%s_%d", base, i)
+	}
+
+	(*m)[name] = entry
+
+	pointer := componentRef(kind, name)
+	in.names[value] = pointer
+
+	return pointer
+}
+
+func nameComponents[V comparable](in *refInternalizer, kind string, m map[string]V) {
+	var zero V
+
+	for _, k := range sortedKeys(m) {
+		if v := m[k]; v != zero {
+			in.names[v] = componentRef(kind, k)
+		}
+	}
+}
+
+// isInternalRef reports whether the ref points into the root document.
+// Refs found in values of external refs are relative to other documents.
+func isInternalRef(ref string, external bool) bool {
+	return ref == "" || (!external && strings.HasPrefix(ref, "#"))
+}
+
+func componentRef(kind, name string) string {
+	return "#/components/" + kind + "/" + escapeJSONPointerToken(name)
+}
+
+func schemasOf(c *Components) *openapi3.Schemas         { return &c.Schemas }
+func messagesOf(c *Components) *Messages                { return &c.Messages }
+func parametersOf(c *Components) *Parameters            { return &c.Parameters }
+func correlationIDsOf(c *Components) *CorrelationIDs    { return &c.CorrelationIds }
+func operationTraitsOf(c *Components) *OperationsTraits { return &c.OperationTraits }
+func messageTraitsOf(c *Components) *MessagesTraits     { return &c.MessageTraits }
+func channelBindingsOf(c *Components) *ChannelsBindings { return &c.ChannelBindings }
@@ -0,0 +1,91 @@
+package spec
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+)
+
+func TestT_InternalizeRefs(t *testing.T) {
+	loader := NewLoader()
+	loader.IsExternalRefsAllowed = true
+	loader.ReadFromURIFunc = ReadFromURIs(readRemoteSchemas, ReadFromFile)
+
+	doc, err := loader.LoadFromFile("./test/loader/external/service/asyncapi.yml")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := doc.InternalizeRefs(nil); err != nil {
+		t.Fatal(err)
+	}
+
+	message := doc.Channels["orders/created"].Subscribe.Value.Message
+	if message.Ref != "#/components/messages/OrderCreated" {
+		t.Fatalf("message ref is not internalized: %q", message.Ref)
+	}
+
+	for _, name := range []string{"Order", "Customer", "TraceID", "OrderCancelled"} {
+		if _, ok := doc.Components.Schemas[name]; !ok {
+			t.Fatalf("schema %q is not moved into components", name)
+		}
+	}
+
+	if len(doc.Components.MessageTraits) != 1 {
+		t.Fatalf("expected the shared trait in one component, got %d", len(doc.Components.MessageTraits))
+	}
+
+	data, err := json.Marshal(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bundled, err := NewLoader().LoadFromData(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := bundled.Validate(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	customer := bundled.Components.Schemas["Order"].Value.Properties["customer"]
+	if customer.Ref != "#/components/schemas/Customer" || customer.Value != bundled.Components.Schemas["Customer"].Value {
+		t.Fatalf("ref local to the external file is not internalized: %q", customer.Ref)
+	}
+}
+
+func TestT_InternalizeRefs_NameCollision(t *testing.T) {
+	doc := &T{
+		Components: &Components{
+			Messages: Messages{
+				"Order": &Message{},
+			},
+		},
+		Channels: Channels{
+			"orders": &Channel{
+				Subscribe: &OperationRef{Value: &Operation{
+					Message: &MessageOneOf{
+						OneOf: []*MessageRef{
+							{Ref: "a.yml#/Order", Value: &Message{MessageTrait: MessageTrait{Name: "a"}}},
+							{Ref: "b.yml#/Order", Value: &Message{MessageTrait: MessageTrait{Name: "b"}}},
+						},
+					},
+				}},
+			},
+		},
+	}
+
+	if err := doc.InternalizeRefs(nil); err != nil {
+		t.Fatal(err)
+	}
+
+	oneOf := doc.Channels["orders"].Subscribe.Value.Message.OneOf
+	if oneOf[0].Ref != "#/components/messages/Order_2" || oneOf[1].Ref != "#/components/messages/Order_3" {
+		t.Fatalf("unexpected refs %q and %q", oneOf[0].Ref, oneOf[1].Ref)
+	}
+
+	if doc.Components.Messages["Order_3"].Name != "b" {
+		t.Fatal("message is moved under a wrong name")
+	}
+}
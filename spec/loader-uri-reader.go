@@ -0,0 +1,104 @@
+package spec
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// ReadFromURIFunc defines a function which reads the contents of a resource
+// located at a URI.
+type ReadFromURIFunc func(loader *Loader, location *url.URL) ([]byte, error)
+
+// ErrURINotSupported indicates the ReadFromURIFunc does not know how to handle a
+// given URI.
+var ErrURINotSupported = errors.New("unsupported URI")
+
+// DefaultReadFromURI reads local file URIs.
+//
+// Remote documents are not read unless a Loader is configured with ReadFromHTTP.
+var DefaultReadFromURI = ReadFromFile
+
+// ReadFromURIs returns a ReadFromURIFunc which tries to read a URI using the
+// given reader functions, in the same order. If a reader function does not
+// support the URI and returns ErrURINotSupported, the next function is checked
+// until a match is found, or the URI is not supported by any.
+func ReadFromURIs(readers ...ReadFromURIFunc) ReadFromURIFunc {
+	return func(loader *Loader, location *url.URL) ([]byte, error) {
+		for _, reader := range readers {
+			data, err := reader(loader, location)
+			if errors.Is(err, ErrURINotSupported) {
+				continue
+			}
+
+			return data, err
+		}
+
+		return nil, ErrURINotSupported
+	}
+}
+
+// ReadFromHTTP returns a ReadFromURIFunc which uses the given http.Client to
+// read the contents from a remote HTTP URI.
+func ReadFromHTTP(cl *http.Client) ReadFromURIFunc {
+	return func(loader *Loader, location *url.URL) ([]byte, error) {
+		if location.Scheme != "http" && location.Scheme != "https" {
+			return nil, ErrURINotSupported
+		}
+
+		resp, err := cl.Get(location.String())
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode > 399 {
+			return nil, fmt.Errorf("error loading %q: request returned status code %d", location.String(), resp.StatusCode)
+		}
+
+		return io.ReadAll(resp.Body)
+	}
+}
+
+// ReadFromFile is a ReadFromURIFunc which reads local file URIs.
+func ReadFromFile(_ *Loader, location *url.URL) ([]byte, error) {
+	if !isFile(location) {
+		return nil, ErrURINotSupported
+	}
+
+	return os.ReadFile(filepath.FromSlash(location.Path))
+}
+
+// ReadFromFS returns a ReadFromURIFunc which reads file URIs from the file system.
+//
+// Paths are resolved relative to the root of the file system.
+func ReadFromFS(fsys fs.FS) ReadFromURIFunc {
+	return func(_ *Loader, location *url.URL) ([]byte, error) {
+		if !isFile(location) {
+			return nil, ErrURINotSupported
+		}
+
+		return fs.ReadFile(fsys, strings.TrimPrefix(path.Clean(location.Path), "/"))
+	}
+}
+
+func isFile(location *url.URL) bool {
+	return location.Path != "" &&
+		location.Host == "" &&
+		(location.Scheme == "" || location.Scheme == "file")
+}
+
+func (loader *Loader) readURL(location *url.URL) ([]byte, error) {
+	if f := loader.ReadFromURIFunc; f != nil {
+		return f(loader, location)
+	}
+
+	return DefaultReadFromURI(loader, location)
+}
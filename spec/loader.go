@@ -0,0 +1,860 @@
+package spec
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/ghodss/yaml"
+)
+
+// Loader helps deserialize an AsyncAPI document and resolve the refs in it.
+type Loader struct {
+	// IsExternalRefsAllowed enables visiting other files
+	IsExternalRefsAllowed bool
+
+	// ReadFromURIFunc allows overriding the file/URL reading func
+	ReadFromURIFunc ReadFromURIFunc
+
+	doc          *T
+	rootLocation *url.URL
+
+	// documents holds every visited document decoded into generic JSON values, keyed by location.
+	// They are used for refs that point outside the components object of the root document.
+	documents map[string]interface{}
+
+	resolved map[string]resolvedRef
+	visited  map[interface{}]struct{}
+
+	// refChain holds the refs being looked up, it is used to detect refs that point to themselves.
+	refChain []string
+}
+
+type resolvedRef struct {
+	value    interface{}
+	location *url.URL
+}
+
+// NewLoader returns an empty Loader
+func NewLoader() *Loader {
+	return &Loader{}
+}
+
+// LoadFromFile loads a document from a local file path
+func (loader *Loader) LoadFromFile(location string) (*T, error) {
+	return loader.LoadFromURI(&url.URL{Path: filepath.ToSlash(location)})
+}
+
+// LoadFromURI loads a document from a URI read by ReadFromURIFunc
+func (loader *Loader) LoadFromURI(location *url.URL) (*T, error) {
+	data, err := loader.readURL(location)
+	if err != nil {
+		return nil, err
+	}
+
+	return loader.LoadFromDataWithPath(data, location)
+}
+
+// LoadFromData parses a JSON or YAML document and resolves refs in it.
+//
+// Relative external refs are resolved against the working directory.
+func (loader *Loader) LoadFromData(data []byte) (*T, error) {
+	return loader.LoadFromDataWithPath(data, nil)
+}
+
+// LoadFromDataWithPath parses a JSON or YAML document and resolves refs in it.
+//
+// Relative external refs are resolved against the location.
+func (loader *Loader) LoadFromDataWithPath(data []byte, location *url.URL) (*T, error) {
+	data, err := yaml.YAMLToJSON(data)
+	if err != nil {
+		return nil, err
+	}
+
+	doc := &T{}
+	if err := json.Unmarshal(data, doc); err != nil {
+		return nil, err
+	}
+
+	var raw interface{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+
+	if err := loader.resolveRefsIn(doc, location, raw); err != nil {
+		return nil, err
+	}
+
+	return doc, nil
+}
+
+// ResolveRefsIn fills the Value of every ref in the document.
+//
+// Relative external refs are resolved against the location.
+// Refs that are already resolved are left as is.
+func (loader *Loader) ResolveRefsIn(doc *T, location *url.URL) error {
+	return loader.resolveRefsIn(doc, location, nil)
+}
+
+func (loader *Loader) resolveRefsIn(doc *T, location *url.URL, raw interface{}) (err error) {
+	if location == nil {
+		location = &url.URL{}
+	} else if location.Path != "" {
+		// Refs from other documents are joined into clean paths, so should be the root one to match them.
+		cleaned := *location
+		cleaned.Path = path.Clean(location.Path)
+		location = &cleaned
+	}
+
+	loader.doc = doc
+	loader.rootLocation = location
+	loader.documents = make(map[string]interface{})
+	loader.resolved = make(map[string]resolvedRef)
+	loader.visited = make(map[interface{}]struct{})
+	loader.refChain = nil
+
+	if raw != nil {
+		loader.documents[documentKey(location)] = raw
+	}
+
+	if components := doc.Components; components != nil {
+		if err = loader.resolveComponents(components, location); err != nil {
+			return
+		}
+	}
+
+	for _, name := range sortedKeys(doc.Servers) {
+		if v := doc.Servers[name]; v != nil {
+			if err = loader.resolveServerBindings(v.Bindings, location); err != nil {
+				return fmt.Errorf("server %q: %w", name, err)
+			}
+		}
+	}
+
+	for _, name := range sortedKeys(doc.Channels) {
+		if err = loader.resolveChannel(doc.Channels[name], location); err != nil {
+			return fmt.Errorf("channel %q: %w", name, err)
+		}
+	}
+
+	return
+}
+
+func (loader *Loader) resolveComponents(components *Components, location *url.URL) (err error) {
+	for _, k := range sortedKeys(components.Schemas) {
+		if err = loader.resolveSchemaRef(components.Schemas[k], location); err != nil {
+			return
+		}
+	}
+
+	for _, k := range sortedKeys(components.Messages) {
+		if err = loader.resolveMessage(components.Messages[k], location); err != nil {
+			return
+		}
+	}
+
+	for _, k := range sortedKeys(components.Parameters) {
+		if err = loader.resolveParameter(components.Parameters[k], location); err != nil {
+			return
+		}
+	}
+
+	for _, k := range sortedKeys(components.OperationTraits) {
+		if err = loader.resolveOperationTrait(components.OperationTraits[k], location); err != nil {
+			return
+		}
+	}
+
+	for _, k := range sortedKeys(components.MessageTraits) {
+		if err = loader.resolveMessageTrait(components.MessageTraits[k], location); err != nil {
+			return
+		}
+	}
+
+	for _, k := range sortedKeys(components.ServerBindings) {
+		if err = loader.resolveServerBindings(components.ServerBindings[k], location); err != nil {
+			return
+		}
+	}
+
+	for _, k := range sortedKeys(components.ChannelBindings) {
+		if err = loader.resolveChannelBindings(components.ChannelBindings[k], location); err != nil {
+			return
+		}
+	}
+
+	for _, k := range sortedKeys(components.OperationBindings) {
+		if err = loader.resolveOperationBindings(components.OperationBindings[k], location); err != nil {
+			return
+		}
+	}
+
+	for _, k := range sortedKeys(components.MessageBindings) {
+		if err = loader.resolveMessageBindings(components.MessageBindings[k], location); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+func (loader *Loader) resolveChannel(channel *Channel, location *url.URL) error {
+	if channel == nil {
+		return nil
+	}
+
+	if err := resolveRefG(loader, channel.Subscribe, location, loader.resolveOperation); err != nil {
+		return err
+	}
+
+	if err := resolveRefG(loader, channel.Publish, location, loader.resolveOperation); err != nil {
+		return err
+	}
+
+	for _, k := range sortedKeys(channel.Parameters) {
+		if err := resolveRefG(loader, channel.Parameters[k], location, loader.resolveParameter); err != nil {
+			return err
+		}
+	}
+
+	return resolveRefG(loader, channel.Bindings, location, loader.resolveChannelBindings)
+}
+
+func (loader *Loader) resolveOperation(operation *Operation, location *url.URL) error {
+	for _, v := range operation.Traits {
+		if err := resolveRefG(loader, v, location, loader.resolveOperationTrait); err != nil {
+			return err
+		}
+	}
+
+	if v := operation.Message; v != nil {
+		if err := resolveRefG(loader, &v.MessageRef, location, loader.resolveMessage); err != nil {
+			return err
+		}
+
+		for _, ent := range v.OneOf {
+			if err := resolveRefG(loader, ent, location, loader.resolveMessage); err != nil {
+				return err
+			}
+		}
+	}
+
+	return loader.resolveOperationTrait(&operation.OperationTrait, location)
+}
+
+func (loader *Loader) resolveOperationTrait(trait *OperationTrait, location *url.URL) error {
+	return loader.resolveOperationBindings(trait.Bindings, location)
+}
+
+func (loader *Loader) resolveMessage(message *Message, location *url.URL) error {
+	if err := loader.resolveSchemaRef(message.Payload, location); err != nil {
+		return err
+	}
+
+	for _, v := range message.Traits {
+		if err := resolveRefG(loader, v, location, loader.resolveMessageTrait); err != nil {
+			return err
+		}
+	}
+
+	return loader.resolveMessageTrait(&message.MessageTrait, location)
+}
+
+func (loader *Loader) resolveMessageTrait(trait *MessageTrait, location *url.URL) error {
+	if err := loader.resolveSchemaRef(trait.Headers, location); err != nil {
+		return err
+	}
+
+	if err := resolveRefG(loader, trait.CorrelationID, location, func(*CorrelationID, *url.URL) error { return nil }); err != nil {
+		return err
+	}
+
+	return loader.resolveMessageBindings(trait.Bindings, location)
+}
+
+func (loader *Loader) resolveParameter(parameter *Parameter, location *url.URL) error {
+	return loader.resolveSchema(parameter.Schema, location)
+}
+
+func (loader *Loader) resolveServerBindings(value *ServerBindings, location *url.URL) error {
+	if value == nil {
+		return nil
+	}
+
+	if v := value.Mqtt; v != nil {
+		if v.SessionExpiryInterval != nil {
+			if err := loader.resolveSchema(v.SessionExpiryInterval.Schema, location); err != nil {
+				return err
+			}
+		}
+
+		if v.MaximumPacketSize != nil {
+			if err := loader.resolveSchema(v.MaximumPacketSize.Schema, location); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (loader *Loader) resolveChannelBindings(value *ChannelBindings, location *url.URL) error {
+	if value == nil {
+		return nil
+	}
+
+	if v := value.Ws; v != nil {
+		if err := loader.resolveSchema(v.Query, location); err != nil {
+			return err
+		}
+
+		if err := loader.resolveSchema(v.Headers, location); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (loader *Loader) resolveOperationBindings(value *OperationBindings, location *url.URL) error {
+	if value == nil {
+		return nil
+	}
+
+	if v := value.Http; v != nil {
+		if err := loader.resolveSchema(v.Query, location); err != nil {
+			return err
+		}
+	}
+
+	if v := value.Kafka; v != nil {
+		if err := loader.resolveSchema(v.GroupID, location); err != nil {
+			return err
+		}
+
+		if err := loader.resolveSchema(v.ClientID, location); err != nil {
+			return err
+		}
+	}
+
+	if v := value.Mqtt; v != nil && v.MessageExpiryInterval != nil {
+		if err := loader.resolveSchema(v.MessageExpiryInterval.Schema, location); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (loader *Loader) resolveMessageBindings(value *MessageBindings, location *url.URL) error {
+	if value == nil {
+		return nil
+	}
+
+	if v := value.Http; v != nil {
+		if err := loader.resolveSchema(v.Headers, location); err != nil {
+			return err
+		}
+	}
+
+	if v := value.Kafka; v != nil {
+		if err := loader.resolveSchema(v.Key, location); err != nil {
+			return err
+		}
+	}
+
+	if v := value.Jms; v != nil {
+		if err := loader.resolveSchema(v.Headers, location); err != nil {
+			return err
+		}
+	}
+
+	if v := value.Mqtt; v != nil {
+		if err := loader.resolveSchema(v.CorrelationData, location); err != nil {
+			return err
+		}
+
+		if v.ResponseTopic != nil {
+			if err := loader.resolveSchema(v.ResponseTopic.Schema, location); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (loader *Loader) resolveSchemaRef(component *openapi3.SchemaRef, location *url.URL) error {
+	if component == nil {
+		return nil
+	}
+
+	value, location, err := loader.derefSchemaRef(component, location)
+	if err != nil {
+		return err
+	}
+
+	return loader.resolveSchema(value, location)
+}
+
+// derefSchemaRef follows the chain of refs starting at the component and fills the Value of every ref in it.
+//
+// Refs in the returned schema are resolved against the returned location.
+func (loader *Loader) derefSchemaRef(component *openapi3.SchemaRef, location *url.URL) (*openapi3.Schema, *url.URL, error) {
+	ref := component.Ref
+	if ref == "" {
+		return component.Value, location, nil
+	}
+
+	refLocation, err := loader.resolveRefLocation(ref, location)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if component.Value != nil {
+		return component.Value, refLocation, nil
+	}
+
+	if err := loader.enterRef(refLocation.String()); err != nil {
+		return nil, nil, err
+	}
+	defer loader.leaveRef()
+
+	found, foundLocation, err := loader.lookup(refLocation, func() interface{} { return &openapi3.SchemaRef{} })
+	if err != nil {
+		return nil, nil, fmt.Errorf("%q: %w", ref, err)
+	}
+
+	target, ok := found.(*openapi3.SchemaRef)
+	if !ok || target == nil {
+		return nil, nil, fmt.Errorf("%q does not point to a schema: %w", ref, ErrUnresolvedRef)
+	}
+
+	value, valueLocation, err := loader.derefSchemaRef(target, foundLocation)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	component.Value = value
+
+	return value, valueLocation, nil
+}
+
+func (loader *Loader) resolveSchema(schema *openapi3.Schema, location *url.URL) error {
+	if schema == nil || !loader.visit(schema) {
+		return nil
+	}
+
+	for _, refs := range []openapi3.SchemaRefs{schema.OneOf, schema.AnyOf, schema.AllOf} {
+		for _, v := range refs {
+			if err := loader.resolveSchemaRef(v, location); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, k := range sortedKeys(schema.Properties) {
+		if err := loader.resolveSchemaRef(schema.Properties[k], location); err != nil {
+			return err
+		}
+	}
+
+	for _, v := range []*openapi3.SchemaRef{schema.Not, schema.Items, schema.AdditionalProperties.Schema} {
+		if err := loader.resolveSchemaRef(v, location); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// resolveRefG fills the Value of the component if needed and resolves refs in the value.
+//
+// Refs in the value are resolved against the location of the document the value is found in.
+func resolveRefG[V refValue](loader *Loader, component *RefG[V], location *url.URL, resolveValue func(V, *url.URL) error) error {
+	if component == nil {
+		return nil
+	}
+
+	var zero V
+
+	if ref := component.Ref; ref != "" {
+		refLocation, err := loader.resolveRefLocation(ref, location)
+		if err != nil {
+			return err
+		}
+
+		location = refLocation
+
+		if component.Value == zero {
+			if err := loader.enterRef(refLocation.String()); err != nil {
+				return err
+			}
+
+			found, foundLocation, err := loader.lookup(refLocation, func() interface{} { return newRefValue[V]() })
+			loader.leaveRef()
+
+			if err != nil {
+				return fmt.Errorf("%q: %w", ref, err)
+			}
+
+			value, ok := found.(V)
+			if !ok || value == zero {
+				return fmt.Errorf("%q does not point to %T: %w", ref, zero, ErrUnresolvedRef)
+			}
+
+			component.Value = value
+			location = foundLocation
+		}
+	}
+
+	if v := component.Value; v != zero && loader.visit(v) {
+		return resolveValue(v, location)
+	}
+
+	return nil
+}
+
+// enterRef pushes the ref onto the chain of refs being looked up.
+//
+// It fails when the ref is already in the chain, that is the ref points to itself through other refs.
+// Such a cycle has no value at the end, unlike recursive schemas that are resolved to shared values.
+func (loader *Loader) enterRef(key string) error {
+	for i, v := range loader.refChain {
+		if v == key {
+			chain := make([]string, 0, len(loader.refChain)-i+1)
+			chain = append(chain, loader.refChain[i:]...)
+
+			return &CircularRefError{Chain: append(chain, key)}
+		}
+	}
+
+	loader.refChain = append(loader.refChain, key)
+
+	return nil
+}
+
+func (loader *Loader) leaveRef() {
+	loader.refChain = loader.refChain[:len(loader.refChain)-1]
+}
+
+// visit reports whether the value is met for the first time.
+func (loader *Loader) visit(value interface{}) bool {
+	if _, ok := loader.visited[value]; ok {
+		return false
+	}
+
+	loader.visited[value] = struct{}{}
+
+	return true
+}
+
+// resolveRefLocation returns the absolute location of the ref found in the document at the location.
+func (loader *Loader) resolveRefLocation(ref string, location *url.URL) (*url.URL, error) {
+	parsed, err := url.Parse(ref)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse reference: %q: %w", ref, err)
+	}
+
+	if strings.HasPrefix(ref, "#") {
+		resolved := *location
+		resolved.Fragment = parsed.Fragment
+		resolved.RawFragment = parsed.RawFragment
+
+		return &resolved, nil
+	}
+
+	if !loader.IsExternalRefsAllowed {
+		return nil, fmt.Errorf("encountered disallowed external reference: %q", ref)
+	}
+
+	if parsed.Scheme != "" || parsed.Host != "" || path.IsAbs(parsed.Path) {
+		return parsed, nil
+	}
+
+	resolved := *location
+	resolved.Path = path.Join(path.Dir(location.Path), parsed.Path)
+	resolved.RawPath = ""
+	resolved.RawQuery = parsed.RawQuery
+	resolved.Fragment = parsed.Fragment
+	resolved.RawFragment = parsed.RawFragment
+
+	return &resolved, nil
+}
+
+// documentKey returns the location without a fragment.
+func documentKey(location *url.URL) string {
+	key := *location
+	key.Fragment = ""
+	key.RawFragment = ""
+
+	return key.String()
+}
+
+// loadDocument returns the document at the location decoded into generic JSON values.
+func (loader *Loader) loadDocument(location *url.URL) (interface{}, error) {
+	key := documentKey(location)
+
+	if raw, ok := loader.documents[key]; ok {
+		return raw, nil
+	}
+
+	var (
+		data []byte
+		err  error
+	)
+
+	if key == documentKey(loader.rootLocation) {
+		data, err = json.Marshal(loader.doc)
+	} else {
+		documentLocation := *location
+		documentLocation.Fragment = ""
+		documentLocation.RawFragment = ""
+
+		if data, err = loader.readURL(&documentLocation); err == nil {
+			data, err = yaml.YAMLToJSON(data)
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	var raw interface{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+
+	loader.documents[key] = raw
+
+	return raw, nil
+}
+
+// lookup returns the value the location points to and the location of the document it is found in.
+//
+// Refs to the entries of the components object and to values defined inline in channels of the root document
+// are resolved to the values the document holds. Other refs are decoded from the raw documents
+// into the value produced by alloc.
+func (loader *Loader) lookup(location *url.URL, alloc func() interface{}) (interface{}, *url.URL, error) {
+	tokens := parseJSONPointer(location.Fragment)
+
+	if documentKey(location) == documentKey(loader.rootLocation) {
+		if len(tokens) == 3 && tokens[0] == "components" {
+			if found, ok := lookupComponent(loader.doc.Components, tokens[1], tokens[2]); ok {
+				return found, loader.rootLocation, nil
+			}
+
+			return nil, nil, foundUnresolvedRef("#" + location.Fragment)
+		}
+
+		if found, ok := lookupChannelValue(loader.doc.Channels, tokens); ok {
+			return found, loader.rootLocation, nil
+		}
+	}
+
+	key := location.String()
+
+	if found, ok := loader.resolved[key]; ok {
+		return found.value, found.location, nil
+	}
+
+	raw, err := loader.loadDocument(location)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	node, ok := lookupJSONPointer(raw, tokens)
+	if !ok {
+		return nil, nil, foundUnresolvedRef(key)
+	}
+
+	if next, ok := refOf(node); ok {
+		nextLocation, err := loader.resolveRefLocation(next, location)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if err := loader.enterRef(nextLocation.String()); err != nil {
+			return nil, nil, err
+		}
+		defer loader.leaveRef()
+
+		found, foundLocation, err := loader.lookup(nextLocation, alloc)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		loader.resolved[key] = resolvedRef{value: found, location: foundLocation}
+
+		return found, foundLocation, nil
+	}
+
+	data, err := json.Marshal(node)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	found := alloc()
+	if err := json.Unmarshal(data, found); err != nil {
+		return nil, nil, err
+	}
+
+	loader.resolved[key] = resolvedRef{value: found, location: location}
+
+	return found, location, nil
+}
+
+func lookupComponent(components *Components, kind, name string) (interface{}, bool) {
+	if components == nil {
+		return nil, false
+	}
+
+	switch kind {
+	case "schemas":
+		return lookupEntry(components.Schemas, name)
+	case "messages":
+		return lookupEntry(components.Messages, name)
+	case "parameters":
+		return lookupEntry(components.Parameters, name)
+	case "correlationIds":
+		return lookupEntry(components.CorrelationIds, name)
+	case "operationTraits":
+		return lookupEntry(components.OperationTraits, name)
+	case "messageTraits":
+		return lookupEntry(components.MessageTraits, name)
+	case "serverBindings":
+		return lookupEntry(components.ServerBindings, name)
+	case "channelBindings":
+		return lookupEntry(components.ChannelBindings, name)
+	case "operationBindings":
+		return lookupEntry(components.OperationBindings, name)
+	case "messageBindings":
+		return lookupEntry(components.MessageBindings, name)
+	}
+
+	return nil, false
+}
+
+// lookupChannelValue returns the value defined inline at the location in the channels,
+// so the value and refs to its location share it.
+func lookupChannelValue(channels Channels, tokens []string) (interface{}, bool) {
+	if len(tokens) < 3 || tokens[0] != "channels" || channels[tokens[1]] == nil {
+		return nil, false
+	}
+
+	channel, tokens := channels[tokens[1]], tokens[2:]
+
+	switch tokens[0] {
+	case "parameters":
+		if len(tokens) == 2 {
+			return inlineValue(channel.Parameters[tokens[1]])
+		}
+	case "bindings":
+		if len(tokens) == 1 {
+			return inlineValue(channel.Bindings)
+		}
+	case "subscribe", "publish":
+		component := channel.Subscribe
+		if tokens[0] == "publish" {
+			component = channel.Publish
+		}
+
+		operation, ok := inlineValue(component)
+		if !ok || len(tokens) == 1 {
+			return operation, ok
+		}
+
+		message := operation.(*Operation).Message
+		if message == nil || tokens[1] != "message" {
+			return nil, false
+		}
+
+		switch {
+		case len(tokens) == 2 && len(message.OneOf) == 0:
+			return inlineValue(&message.MessageRef)
+		case len(tokens) == 4 && tokens[2] == "oneOf":
+			i, err := strconv.Atoi(tokens[3])
+			if err != nil || i < 0 || i >= len(message.OneOf) {
+				return nil, false
+			}
+
+			return inlineValue(message.OneOf[i])
+		}
+	}
+
+	return nil, false
+}
+
+// inlineValue returns the value of the component unless it is a ref.
+func inlineValue[V refValue](component *RefG[V]) (interface{}, bool) {
+	var zero V
+
+	if component == nil || component.Ref != "" || component.Value == zero {
+		return nil, false
+	}
+
+	return component.Value, true
+}
+
+func lookupEntry[V any](m map[string]V, name string) (interface{}, bool) {
+	v, ok := m[name]
+
+	return v, ok
+}
+
+// parseJSONPointer splits the pointer into unescaped reference tokens.
+func parseJSONPointer(pointer string) []string {
+	pointer = strings.TrimPrefix(pointer, "/")
+	if pointer == "" {
+		return nil
+	}
+
+	tokens := strings.Split(pointer, "/")
+	for i, token := range tokens {
+		tokens[i] = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+	}
+
+	return tokens
+}
+
+// refOf returns the ref the node consists of, if any.
+func refOf(node interface{}) (string, bool) {
+	m, ok := node.(map[string]interface{})
+	if !ok {
+		return "", false
+	}
+
+	ref, ok := m["$ref"].(string)
+
+	return ref, ok
+}
+
+func lookupJSONPointer(node interface{}, tokens []string) (interface{}, bool) {
+	for _, token := range tokens {
+		switch v := node.(type) {
+		case map[string]interface{}:
+			next, ok := v[token]
+			if !ok {
+				return nil, false
+			}
+
+			node = next
+		case []interface{}:
+			i, err := strconv.Atoi(token)
+			if err != nil || i < 0 || i >= len(v) {
+				return nil, false
+			}
+
+			node = v[i]
+		default:
+			return nil, false
+		}
+	}
+
+	return node, true
+}
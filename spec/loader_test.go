@@ -0,0 +1,387 @@
+package spec
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestLoader_LoadFromData(t *testing.T) {
+	data, err := os.ReadFile("./test/loader/internal.yml")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	doc, err := NewLoader().LoadFromData(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := doc.Validate(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	measured := doc.Channels["smartylighting/streetlights/1/0/event/{streetlightId}/lighting/measured"]
+
+	message := measured.Subscribe.Value.Message
+	if message.Value != doc.Components.Messages["lightMeasured"] {
+		t.Fatalf("message ref is resolved to %p, not to the component", message.Value)
+	}
+
+	if message.Value.Traits[0].Value != doc.Components.MessageTraits["commonHeaders"] {
+		t.Fatal("message trait ref is not resolved to the component")
+	}
+
+	if measured.Subscribe.Value.Traits[0].Value != doc.Components.OperationTraits["kafka"] {
+		t.Fatal("operation trait ref is not resolved to the component")
+	}
+
+	if measured.Parameters["streetlightId"].Value != doc.Components.Parameters["streetlightId"] {
+		t.Fatal("parameter ref is not resolved to the component")
+	}
+
+	sentAt := doc.Components.Schemas["sentAt"].Value
+	if got := message.Value.Payload.Value.Properties["sentAt"].Value; got != sentAt {
+		t.Fatal("nested schema ref is not resolved to the component")
+	}
+
+	dim := doc.Channels["smartylighting/streetlights/1/0/action/{streetlightId}/dim"]
+	if dim.Bindings.Value != doc.Components.ChannelBindings["ws"] {
+		t.Fatal("channel bindings ref is not resolved to the component")
+	}
+
+	if dim.Publish.Value.Message.Value.CorrelationID.Value != doc.Components.CorrelationIds["default"] {
+		t.Fatal("correlation id ref is not resolved to the component")
+	}
+
+	oneOf := doc.Channels["smartylighting/streetlights/1/0/action/{streetlightId}/turn/on"].Publish.Value.Message.OneOf
+	if len(oneOf) != 2 {
+		t.Fatalf("expected 2 messages in oneOf, got %d", len(oneOf))
+	}
+
+	if oneOf[1].Value == nil || oneOf[1].Value.Name != "dimLight" {
+		t.Fatal("ref to a message outside components is not resolved")
+	}
+
+	if oneOf[1].Value != dim.Publish.Value.Message.Value {
+		t.Fatal("ref to a message defined in a channel is not resolved to the value of the channel")
+	}
+}
+
+func TestLoader_LoadFromData_InlineChannelValues(t *testing.T) {
+	doc, err := NewLoader().LoadFromData([]byte(`
+asyncapi: 2.0.0
+info:
+  title: Orders API
+  version: 1.0.0
+channels:
+  orders/{id}/created:
+    parameters:
+      id:
+        schema:
+          type: string
+    subscribe:
+      operationId: onOrder
+      message:
+        oneOf:
+          - name: orderCreated
+          - name: orderRestored
+  orders/{id}/deleted:
+    parameters:
+      id:
+        $ref: '#/channels/orders~1{id}~1created/parameters/id'
+    subscribe:
+      $ref: '#/channels/orders~1{id}~1created/subscribe'
+    publish:
+      message:
+        $ref: '#/channels/orders~1{id}~1created/subscribe/message/oneOf/1'
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	created, deleted := doc.Channels["orders/{id}/created"], doc.Channels["orders/{id}/deleted"]
+
+	if deleted.Parameters["id"].Value != created.Parameters["id"].Value {
+		t.Fatal("ref to a parameter defined in a channel is not resolved to the value of the channel")
+	}
+
+	if deleted.Subscribe.Value != created.Subscribe.Value {
+		t.Fatal("ref to an operation defined in a channel is not resolved to the value of the channel")
+	}
+
+	if deleted.Publish.Value.Message.Value != created.Subscribe.Value.Message.OneOf[1].Value {
+		t.Fatal("ref to a message of oneOf defined in a channel is not resolved to the value of the channel")
+	}
+}
+
+func TestLoader_ResolveRefsIn_Unresolved(t *testing.T) {
+	doc := &T{
+		AsyncAPI: asyncAPIVersion,
+		Channels: Channels{
+			"orders": &Channel{
+				Parameters: ParametersRefs{
+					"id": &ParameterRef{Ref: "#/components/parameters/id"},
+				},
+			},
+		},
+	}
+
+	err := NewLoader().ResolveRefsIn(doc, nil)
+	if !errors.Is(err, ErrUnresolvedRef) {
+		t.Fatalf("expected ErrUnresolvedRef, got %v", err)
+	}
+}
+
+func readRemoteSchemas(loader *Loader, location *url.URL) ([]byte, error) {
+	if location.Host != "schemas.example.com" {
+		return nil, ErrURINotSupported
+	}
+
+	return []byte(`{"OrderCancelled": {"type": "object", "properties": {"reason": {"type": "string"}}}}`), nil
+}
+
+func checkExternalDocument(t *testing.T, doc *T) {
+	t.Helper()
+
+	if err := doc.Validate(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	created := doc.Channels["orders/created"].Subscribe.Value.Message.Value
+	if created == nil || created.Name != "orderCreated" {
+		t.Fatal("ref to a message in other file is not resolved")
+	}
+
+	order := created.Payload.Value
+	if order == nil || order.Properties["customer"].Value == nil {
+		t.Fatal("ref relative to the message file is not resolved")
+	}
+
+	if order.Properties["customer"].Value.Properties["name"] == nil {
+		t.Fatal("ref local to the schemas file is not resolved")
+	}
+
+	cancelled := doc.Components.Messages["orderCancelled"]
+	if cancelled.Traits[0].Value != created.Traits[0].Value {
+		t.Fatal("refs to the same trait from different files are resolved to different values")
+	}
+
+	if cancelled.Traits[0].Value.Headers.Value.Properties["traceId"].Value == nil {
+		t.Fatal("ref relative to the trait file is not resolved")
+	}
+
+	if cancelled.Payload.Value == nil || cancelled.Payload.Value.Properties["reason"] == nil {
+		t.Fatal("remote ref is not resolved")
+	}
+}
+
+func TestLoader_LoadFromFile_External(t *testing.T) {
+	loader := NewLoader()
+	loader.IsExternalRefsAllowed = true
+	loader.ReadFromURIFunc = ReadFromURIs(readRemoteSchemas, ReadFromFile)
+
+	doc, err := loader.LoadFromFile("./test/loader/external/service/asyncapi.yml")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	checkExternalDocument(t, doc)
+}
+
+func TestLoader_LoadFromFile_FS(t *testing.T) {
+	loader := NewLoader()
+	loader.IsExternalRefsAllowed = true
+	loader.ReadFromURIFunc = ReadFromURIs(readRemoteSchemas, ReadFromFS(os.DirFS("./test/loader/external")))
+
+	doc, err := loader.LoadFromFile("service/asyncapi.yml")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	checkExternalDocument(t, doc)
+}
+
+func TestLoader_LoadFromFile_ExternalNotAllowed(t *testing.T) {
+	if _, err := NewLoader().LoadFromFile("./test/loader/external/service/asyncapi.yml"); err == nil {
+		t.Fatal("expected an error for external refs")
+	}
+}
+
+func TestLoader_LoadFromData_RecursiveSchema(t *testing.T) {
+	doc, err := NewLoader().LoadFromData([]byte(`
+asyncapi: 2.0.0
+info:
+  title: Tree API
+  version: 1.0.0
+channels:
+  trees:
+    subscribe:
+      message:
+        payload:
+          $ref: '#/components/schemas/Node'
+components:
+  schemas:
+    Node:
+      type: object
+      properties:
+        children:
+          type: array
+          items:
+            $ref: '#/components/schemas/Node'
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := doc.Validate(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	node := doc.Components.Schemas["Node"].Value
+	if node.Properties["children"].Value.Items.Value != node {
+		t.Fatal("recursive schema is not resolved to the shared value")
+	}
+
+	if doc.Channels["trees"].Subscribe.Value.Message.Value.Payload.Value != node {
+		t.Fatal("payload is not resolved to the shared value")
+	}
+}
+
+func TestLoader_LoadFromData_CircularRef(t *testing.T) {
+	tests := []struct {
+		name  string
+		data  string
+		chain []string
+	}{
+		{
+			name: "schemas",
+			data: `
+asyncapi: 2.0.0
+components:
+  schemas:
+    A:
+      $ref: '#/components/schemas/B'
+    B:
+      $ref: '#/components/schemas/A'
+`,
+			chain: []string{"#/components/schemas/B", "#/components/schemas/A", "#/components/schemas/B"},
+		},
+		{
+			name: "message traits",
+			data: `
+asyncapi: 2.0.0
+x-traits:
+  a:
+    $ref: '#/x-traits/b'
+  b:
+    $ref: '#/x-traits/a'
+components:
+  messages:
+    orderCreated:
+      traits:
+        - $ref: '#/x-traits/a'
+`,
+			chain: []string{"#/x-traits/a", "#/x-traits/b", "#/x-traits/a"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewLoader().LoadFromData([]byte(tt.data))
+			if !errors.Is(err, ErrCircularRef) {
+				t.Fatalf("expected ErrCircularRef, got %v", err)
+			}
+
+			var circular *CircularRefError
+			if !errors.As(err, &circular) {
+				t.Fatalf("expected CircularRefError, got %T", err)
+			}
+
+			if got, want := strings.Join(circular.Chain, " "), strings.Join(tt.chain, " "); got != want {
+				t.Fatalf("expected chain %q, got %q", want, got)
+			}
+		})
+	}
+}
+
+func TestLoader_ServerBindingSchemas(t *testing.T) {
+	loader := NewLoader()
+	loader.IsExternalRefsAllowed = true
+	loader.ReadFromURIFunc = func(_ *Loader, location *url.URL) ([]byte, error) {
+		if location.Host != "schemas.example.com" {
+			return nil, ErrURINotSupported
+		}
+
+		return []byte(`{"PacketSize": {"type": "integer", "minimum": 1}}`), nil
+	}
+
+	doc, err := loader.LoadFromData([]byte(`
+asyncapi: 2.0.0
+info:
+  title: Fleet API
+  version: 1.0.0
+servers:
+  production:
+    url: mqtt.example.com
+    protocol: mqtt
+    bindings:
+      mqtt:
+        sessionExpiryInterval:
+          allOf:
+            - $ref: '#/components/schemas/interval'
+        bindingVersion: 0.2.0
+channels: {}
+components:
+  schemas:
+    interval:
+      type: integer
+      minimum: 0
+  serverBindings:
+    fleet:
+      mqtt:
+        maximumPacketSize:
+          allOf:
+            - $ref: 'https://schemas.example.com/fleet.json#/PacketSize'
+        bindingVersion: 0.2.0
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	interval := doc.Servers["production"].Bindings.Mqtt.SessionExpiryInterval.Schema.AllOf[0]
+	if interval.Value != doc.Components.Schemas["interval"].Value {
+		t.Fatal("schema ref in the server binding is not resolved")
+	}
+
+	packetSize := doc.Components.ServerBindings["fleet"].Mqtt.MaximumPacketSize.Schema.AllOf[0]
+	if packetSize.Value == nil {
+		t.Fatal("schema ref in the server binding of components is not resolved")
+	}
+
+	c, err := doc.Dereference()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	out, err := json.Marshal(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if strings.Contains(string(out), `"$ref"`) {
+		t.Fatalf("dereferenced document has refs: %s", out)
+	}
+
+	if err := doc.InternalizeRefs(nil); err != nil {
+		t.Fatal(err)
+	}
+
+	if packetSize.Ref != "#/components/schemas/PacketSize" || doc.Components.Schemas["PacketSize"] == nil {
+		t.Fatalf("schema ref in the server binding of components is not internalized: %q", packetSize.Ref)
+	}
+}
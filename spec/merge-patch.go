@@ -0,0 +1,107 @@
+package spec
+
+import (
+	"reflect"
+)
+
+// mergePatch merges the patch into the base following JSON Merge Patch semantics (RFC 7396)
+// on typed values: objects are merged key by key, other values present in the patch replace the base ones.
+//
+// Values are not changed, merged objects are new ones and the rest is shared.
+// Zero values of the patch mean absent fields, so the patch can not remove fields.
+func mergePatch[V any](base, patch V) V {
+	m := &merger{merged: make(map[[2]uintptr]reflect.Value)}
+
+	return m.merge(reflect.ValueOf(&base).Elem(), reflect.ValueOf(&patch).Elem()).Interface().(V)
+}
+
+type merger struct {
+	// merged maps pairs of merged pointers to results, so recursive values are merged once.
+	merged map[[2]uintptr]reflect.Value
+}
+
+func (m *merger) merge(base, patch reflect.Value) reflect.Value {
+	if patch.IsZero() {
+		return base
+	}
+
+	if base.IsZero() {
+		return patch
+	}
+
+	switch base.Kind() {
+	case reflect.Pointer:
+		if base.Pointer() == patch.Pointer() || base.Elem().Kind() != reflect.Struct {
+			return patch
+		}
+
+		key := [2]uintptr{base.Pointer(), patch.Pointer()}
+		if v, ok := m.merged[key]; ok {
+			return v
+		}
+
+		v := reflect.New(base.Type().Elem())
+		m.merged[key] = v
+		v.Elem().Set(m.mergeStruct(base.Elem(), patch.Elem()))
+
+		return v
+	case reflect.Struct:
+		return m.mergeStruct(base, patch)
+	case reflect.Map:
+		v := reflect.MakeMapWithSize(base.Type(), base.Len()+patch.Len())
+
+		iter := base.MapRange()
+		for iter.Next() {
+			v.SetMapIndex(iter.Key(), iter.Value())
+		}
+
+		iter = patch.MapRange()
+		for iter.Next() {
+			if prev := base.MapIndex(iter.Key()); prev.IsValid() {
+				v.SetMapIndex(iter.Key(), m.merge(prev, iter.Value()))
+			} else {
+				v.SetMapIndex(iter.Key(), iter.Value())
+			}
+		}
+
+		return v
+	case reflect.Interface:
+		if b, p := base.Elem(), patch.Elem(); b.Type() == p.Type() && b.Kind() == reflect.Map {
+			v := reflect.New(base.Type()).Elem()
+			v.Set(m.merge(b, p))
+
+			return v
+		}
+
+		return patch
+	default:
+		return patch
+	}
+}
+
+func (m *merger) mergeStruct(base, patch reflect.Value) reflect.Value {
+	v := reflect.New(base.Type()).Elem()
+	v.Set(base)
+
+	for i := 0; i < v.NumField(); i++ {
+		if !v.Type().Field(i).IsExported() {
+			continue
+		}
+
+		v.Field(i).Set(m.merge(base.Field(i), patch.Field(i)))
+	}
+
+	// Refs keep pointing to their values only if the values are not merged.
+	if ref, value := v.FieldByName("Ref"), v.FieldByName("Value"); ref.Kind() == reflect.String && value.Kind() == reflect.Pointer {
+		switch value.Pointer() {
+		case patch.FieldByName("Value").Pointer():
+			ref.Set(patch.FieldByName("Ref"))
+		case base.FieldByName("Value").Pointer():
+			ref.Set(base.FieldByName("Ref"))
+		default:
+			ref.SetString("")
+		}
+	}
+
+	return v
+}
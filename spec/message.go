@@ -3,8 +3,12 @@ package spec
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"strconv"
 
 	"github.com/getkin/kin-openapi/openapi3"
+
+	"github.com/rdmrcv/go-asyncapi2/spec/validate"
 )
 
 type Messages map[string]*Message
@@ -105,20 +109,24 @@ func (value *MessageTrait) UnmarshalJSON(data []byte) error {
 }
 
 func (value *MessageTrait) Validate(ctx context.Context) error {
+	if err := validate.Extensions(ctx, value.Extensions); err != nil {
+		return err
+	}
+
 	if v := value.Headers; v != nil {
-		if err := v.Validate(ctx); err != nil {
+		if err := validateSchemaRef(validate.At(ctx, "headers"), v); err != nil {
 			return err
 		}
 	}
 
 	if v := value.CorrelationID; v != nil {
-		if err := v.Validate(ctx); err != nil {
+		if err := v.Validate(validate.At(ctx, "correlationId")); err != nil {
 			return err
 		}
 	}
 
 	if v := value.Bindings; v != nil {
-		if err := v.Validate(ctx); err != nil {
+		if err := v.Validate(validate.At(ctx, "bindings")); err != nil {
 			return err
 		}
 	}
@@ -148,33 +156,88 @@ func (value *Message) MarshalJSON() ([]byte, error) {
 }
 
 func (value *Message) UnmarshalJSON(data []byte) error {
-	type MessageBis Message
+	// MessageBis does not embed MessageTrait, otherwise its UnmarshalJSON is promoted and hides own fields.
+	type MessageBis struct {
+		Payload *openapi3.SchemaRef `json:"payload,omitempty"`
+		Traits  []*MessageTraitRef  `json:"traits,omitempty"`
+	}
 	var x MessageBis
 	if err := json.Unmarshal(data, &x); err != nil {
 		return err
 	}
 
-	if err := x.MessageTrait.UnmarshalJSON(data); err != nil {
+	var trait MessageTrait
+	if err := trait.UnmarshalJSON(data); err != nil {
 		return err
 	}
 
-	*value = Message(x)
+	delete(trait.Extensions, "payload")
+	delete(trait.Extensions, "traits")
+
+	*value = Message{
+		MessageTrait: trait,
+		Payload:      x.Payload,
+		Traits:       x.Traits,
+	}
 
 	return nil
 }
 
 func (value *Message) Validate(ctx context.Context) error {
 	if v := value.Payload; v != nil {
-		if err := v.Validate(ctx); err != nil {
+		if err := validateSchemaRef(validate.At(ctx, "payload"), v); err != nil {
 			return err
 		}
 	}
 
-	for _, v := range value.Traits {
-		if err := v.Validate(ctx); err != nil {
+	for i, v := range value.Traits {
+		if err := v.Validate(validate.At(ctx, "traits", strconv.Itoa(i))); err != nil {
 			return err
 		}
 	}
 
-	return value.MessageTrait.Validate(ctx)
+	if err := value.MessageTrait.Validate(ctx); err != nil {
+		return err
+	}
+
+	if validate.GetOptions(ctx).ExamplesValidationEnabled() {
+		return value.validateExamples(ctx)
+	}
+
+	return nil
+}
+
+// validateExamples checks payloads and headers of examples against schemas of the message with traits applied.
+func (value *Message) validateExamples(ctx context.Context) error {
+	message, err := value.ApplyTraits()
+	if err != nil {
+		// Unresolved traits are reported by the validation of traits.
+		return nil
+	}
+
+	for i, example := range message.Examples {
+		ctx := validate.At(ctx, "examples", strconv.Itoa(i))
+
+		if err := validateExample(validate.At(ctx, "payload"), message.Payload, example["payload"]); err != nil {
+			return err
+		}
+
+		if err := validateExample(validate.At(ctx, "headers"), message.Headers, example["headers"]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateExample(ctx context.Context, schema *openapi3.SchemaRef, example interface{}) error {
+	if schema == nil || schema.Value == nil || example == nil {
+		return nil
+	}
+
+	if err := schema.Value.VisitJSON(example, validate.GetOptions(ctx).SchemaVisitOptions()...); err != nil {
+		return validate.Report(ctx, validate.RuleExample, fmt.Errorf("%w: %w", err, validate.ErrWrongField))
+	}
+
+	return nil
 }
@@ -0,0 +1,103 @@
+package spec
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/rdmrcv/go-asyncapi2/spec/validate"
+)
+
+func TestMessage_Validate_Examples(t *testing.T) {
+	doc, err := NewLoader().LoadFromData([]byte(`
+asyncapi: 2.0.0
+info:
+  title: Orders API
+  version: 1.0.0
+components:
+  messages:
+    order:
+      payload:
+        type: object
+        properties:
+          id:
+            type: integer
+      examples:
+        - payload: {id: 1}
+          headers: {traceId: abc}
+        - payload: {id: one}
+        - headers: {traceId: 1}
+      traits:
+        - $ref: '#/components/messageTraits/traced'
+  messageTraits:
+    traced:
+      headers:
+        type: object
+        properties:
+          traceId:
+            type: string
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = doc.ValidateAll(context.Background())
+
+	var errs validate.Errors
+	if !errors.As(err, &errs) {
+		t.Fatalf("expected validate.Errors, got %v", err)
+	}
+
+	want := []string{
+		"/components/messages/order/examples/1/payload",
+		"/components/messages/order/examples/2/headers",
+	}
+
+	if len(errs) != len(want) {
+		t.Fatalf("expected %d errors, got %d:\n%v", len(want), len(errs), err)
+	}
+
+	for i, v := range want {
+		if errs[i].Pointer != v || errs[i].Rule != validate.RuleExample {
+			t.Errorf("expected example error at %s, got %s error at %s", v, errs[i].Rule, errs[i].Pointer)
+		}
+	}
+
+	if err := doc.Validate(context.Background(), validate.DisableExamplesValidation()); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestMessage_Validate_ExamplesContextOptions(t *testing.T) {
+	doc, err := NewLoader().LoadFromData([]byte(`
+asyncapi: 2.0.0
+info:
+  title: Orders API
+  version: 1.0.0
+components:
+  messages:
+    order:
+      payload:
+        type: object
+        properties:
+          id:
+            type: string
+            pattern: '^[A-Z]+-[0-9]+$'
+      examples:
+        - payload: {id: '42'}
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = doc.ValidateAll(context.Background())
+
+	var errs validate.Errors
+	if !errors.As(err, &errs) || len(errs) != 1 || errs[0].Rule != validate.RuleExample {
+		t.Fatalf("expected the pattern error of the example, got %v", err)
+	}
+
+	if err := doc.Validate(context.Background(), validate.DisableSchemaPatternValidation()); err != nil {
+		t.Fatal(err)
+	}
+}
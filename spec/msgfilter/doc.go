@@ -0,0 +1,4 @@
+// Package msgfilter validates messages flowing through brokers against AsyncAPIv2 specification documents.
+//
+// It is modelled after openapi3filter of github.com/getkin/kin-openapi.
+package msgfilter
@@ -0,0 +1,69 @@
+package msgfilter
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/rdmrcv/go-asyncapi2/spec"
+)
+
+var (
+	ErrChannelNotFound        = errors.New("channel is not found")
+	ErrOperationNotFound      = errors.New("operation is not found")
+	ErrUnsupportedContentType = errors.New("content type is not supported")
+)
+
+// Reasons of MessageError.
+const (
+	ReasonContentType = "content type does not match"
+	ReasonDecode      = "payload can not be decoded"
+	ReasonPayload     = "payload does not match the schema"
+	ReasonHeaders     = "headers do not match the schema"
+)
+
+// MessageError is returned when the message does not match a message of the operation.
+type MessageError struct {
+	Input *MessageValidationInput
+	// Message is the message of the operation, with traits applied, the input is checked against.
+	Message *spec.Message
+	// Reason is one of Reason constants.
+	Reason string
+	Err    error
+}
+
+func (err *MessageError) Error() string {
+	if name := err.Message.Name; name != "" {
+		return fmt.Sprintf("message %q: %s: %v", name, err.Reason, err.Err)
+	}
+
+	return fmt.Sprintf("%s: %v", err.Reason, err.Err)
+}
+
+func (err *MessageError) Unwrap() error {
+	return err.Err
+}
+
+// NoMatchingMessageError is returned when the operation has several messages and the input matches none of them.
+type NoMatchingMessageError struct {
+	// Errors holds an error for each message of the operation.
+	Errors []*MessageError
+}
+
+func (err *NoMatchingMessageError) Error() string {
+	lines := make([]string, 0, len(err.Errors))
+	for _, v := range err.Errors {
+		lines = append(lines, v.Error())
+	}
+
+	return "message matches none of messages of the operation:\n" + strings.Join(lines, "\n")
+}
+
+func (err *NoMatchingMessageError) Unwrap() []error {
+	errs := make([]error, 0, len(err.Errors))
+	for _, v := range err.Errors {
+		errs = append(errs, v)
+	}
+
+	return errs
+}
@@ -0,0 +1,14 @@
+package msgfilter
+
+// Options used by ValidateMessage.
+type Options struct {
+	// ExcludePayload skips validation of the payload.
+	ExcludePayload bool
+	// ExcludeHeaders skips validation of the headers.
+	ExcludeHeaders bool
+	// MultiError makes schema errors hold all problems found, not only the first one.
+	MultiError bool
+}
+
+// DefaultOptions is used when the input has no options.
+var DefaultOptions = &Options{}
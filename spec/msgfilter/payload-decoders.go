@@ -0,0 +1,92 @@
+package msgfilter
+
+import (
+	"encoding/json"
+	"fmt"
+	"mime"
+	"strings"
+	"sync"
+)
+
+// PayloadDecoder decodes the raw payload into a value schemas are checked against,
+// that is a value encoding/json decodes into interface{}.
+type PayloadDecoder func(payload []byte) (interface{}, error)
+
+var (
+	payloadDecodersMu sync.RWMutex
+	payloadDecoders   = map[string]PayloadDecoder{
+		"application/json": jsonPayloadDecoder,
+		"text/plain":       plainPayloadDecoder,
+	}
+)
+
+// RegisterPayloadDecoder registers the decoder of payloads of the content type.
+func RegisterPayloadDecoder(contentType string, decoder PayloadDecoder) {
+	payloadDecodersMu.Lock()
+	defer payloadDecodersMu.Unlock()
+
+	payloadDecoders[contentType] = decoder
+}
+
+// UnregisterPayloadDecoder removes the decoder of payloads of the content type.
+func UnregisterPayloadDecoder(contentType string) {
+	payloadDecodersMu.Lock()
+	defer payloadDecodersMu.Unlock()
+
+	delete(payloadDecoders, contentType)
+}
+
+// RegisteredPayloadDecoder returns the decoder of payloads of the content type.
+// Content types with the +json suffix fall back to the decoder of application/json.
+func RegisteredPayloadDecoder(contentType string) PayloadDecoder {
+	mediaType := parseMediaType(contentType)
+
+	payloadDecodersMu.RLock()
+	defer payloadDecodersMu.RUnlock()
+
+	if decoder, ok := payloadDecoders[mediaType]; ok {
+		return decoder
+	}
+
+	if strings.HasSuffix(mediaType, "+json") {
+		return payloadDecoders["application/json"]
+	}
+
+	return nil
+}
+
+func decodePayload(contentType string, payload []byte) (interface{}, error) {
+	decoder := RegisteredPayloadDecoder(contentType)
+	if decoder == nil {
+		return nil, fmt.Errorf("%q: %w", contentType, ErrUnsupportedContentType)
+	}
+
+	return decoder(payload)
+}
+
+// parseMediaType returns the media type without parameters.
+func parseMediaType(contentType string) string {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return strings.ToLower(strings.TrimSpace(contentType))
+	}
+
+	return mediaType
+}
+
+func jsonPayloadDecoder(payload []byte) (interface{}, error) {
+	if len(payload) == 0 {
+		return nil, nil
+	}
+
+	var value interface{}
+	if err := json.Unmarshal(payload, &value); err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+func plainPayloadDecoder(payload []byte) (interface{}, error) {
+	return string(payload), nil
+}
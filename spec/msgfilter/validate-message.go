@@ -0,0 +1,221 @@
+package msgfilter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/getkin/kin-openapi/openapi3"
+
+	"github.com/rdmrcv/go-asyncapi2/spec"
+	"github.com/rdmrcv/go-asyncapi2/spec/validate"
+)
+
+// Direction names the operation of the channel the message belongs to.
+type Direction string
+
+const (
+	Publish   Direction = "publish"
+	Subscribe Direction = "subscribe"
+)
+
+// defaultContentType is the content type of messages when neither they nor the document set one,
+// as the AsyncAPI specification defines.
+const defaultContentType = "application/json"
+
+// Message is a concrete message flowing through a broker.
+type Message struct {
+	// ContentType of the payload. When empty, the content type of the message of the operation is used.
+	ContentType string
+	// Headers hold values of headers. Strings are converted to numbers and booleans
+	// when schemas of the headers require them, as brokers often carry headers as strings.
+	Headers map[string]interface{}
+	Payload []byte
+}
+
+// MessageValidationInput is the input of ValidateMessage.
+type MessageValidationInput struct {
+	// Doc must have refs resolved, see spec.Loader.
+	Doc       *spec.T
+	Channel   string
+	Direction Direction
+	Message   *Message
+	Options   *Options
+}
+
+// ValidateMessage checks the message against messages of the operation of the channel
+// and returns the one the message matches, with traits applied.
+//
+// When the operation has several messages, the first matching one is returned, and when there is none,
+// NoMatchingMessageError is returned. When the operation has one message, MessageError is returned.
+// When the operation has no message, any message matches and nil is returned.
+//
+// Schema format and pattern options the context carries, see validate.WithOptions, apply to payloads and headers.
+func ValidateMessage(ctx context.Context, input *MessageValidationInput) (*spec.Message, error) {
+	candidates, err := input.messages()
+	if err != nil {
+		return nil, err
+	}
+
+	errs := make([]*MessageError, 0, len(candidates))
+
+	for _, candidate := range candidates {
+		err := input.validate(ctx, candidate)
+		if err == nil {
+			return candidate, nil
+		}
+
+		errs = append(errs, err)
+	}
+
+	switch len(errs) {
+	case 0:
+		return nil, nil
+	case 1:
+		return nil, errs[0]
+	default:
+		return nil, &NoMatchingMessageError{Errors: errs}
+	}
+}
+
+// messages returns messages of the operation with traits applied.
+func (input *MessageValidationInput) messages() ([]*spec.Message, error) {
+	channel := input.Doc.Channels[input.Channel]
+	if channel == nil {
+		return nil, fmt.Errorf("%q: %w", input.Channel, ErrChannelNotFound)
+	}
+
+	operation := channel.Subscribe
+	if input.Direction == Publish {
+		operation = channel.Publish
+	}
+
+	if operation == nil || operation.Value == nil {
+		return nil, fmt.Errorf("%s of %q: %w", input.Direction, input.Channel, ErrOperationNotFound)
+	}
+
+	message := operation.Value.Message
+	if message == nil {
+		return nil, nil
+	}
+
+	refs := message.OneOf
+	if len(refs) == 0 {
+		refs = []*spec.MessageRef{&message.MessageRef}
+	}
+
+	messages := make([]*spec.Message, 0, len(refs))
+
+	for _, ref := range refs {
+		if ref == nil {
+			continue
+		}
+
+		if ref.Value == nil {
+			return nil, fmt.Errorf("%q: %w", ref.Ref, spec.ErrUnresolvedRef)
+		}
+
+		v, err := ref.Value.ApplyTraits()
+		if err != nil {
+			return nil, err
+		}
+
+		messages = append(messages, v)
+	}
+
+	return messages, nil
+}
+
+func (input *MessageValidationInput) validate(ctx context.Context, message *spec.Message) *MessageError {
+	options := input.Options
+	if options == nil {
+		options = DefaultOptions
+	}
+
+	schemaOpts := validate.GetOptions(ctx).SchemaVisitOptions()
+	if options.MultiError {
+		schemaOpts = append(schemaOpts, openapi3.MultiErrors())
+	}
+
+	fail := func(reason string, err error) *MessageError {
+		return &MessageError{Input: input, Message: message, Reason: reason, Err: err}
+	}
+
+	contentType := message.ContentType
+	if contentType == "" {
+		contentType = input.Doc.DefaultContentType
+	}
+
+	if v := input.Message.ContentType; v != "" {
+		if contentType != "" && parseMediaType(v) != parseMediaType(contentType) {
+			return fail(ReasonContentType, fmt.Errorf("expected %q, got %q", contentType, v))
+		}
+
+		contentType = v
+	}
+
+	if contentType == "" {
+		contentType = defaultContentType
+	}
+
+	if schema := message.Payload; !options.ExcludePayload && schema != nil && schema.Value != nil {
+		payload, err := decodePayload(contentType, input.Message.Payload)
+		if err != nil {
+			return fail(ReasonDecode, err)
+		}
+
+		if err := schema.Value.VisitJSON(payload, schemaOpts...); err != nil {
+			return fail(ReasonPayload, err)
+		}
+	}
+
+	if schema := message.Headers; !options.ExcludeHeaders && schema != nil && schema.Value != nil {
+		headers, err := decodeHeaders(input.Message.Headers, schema.Value)
+		if err != nil {
+			return fail(ReasonHeaders, err)
+		}
+
+		if err := schema.Value.VisitJSON(headers, schemaOpts...); err != nil {
+			return fail(ReasonHeaders, err)
+		}
+	}
+
+	return nil
+}
+
+// decodeHeaders converts headers into values encoding/json decodes into interface{},
+// parsing strings of headers the schema declares as numbers or booleans.
+func decodeHeaders(headers map[string]interface{}, schema *openapi3.Schema) (map[string]interface{}, error) {
+	decoded := make(map[string]interface{}, len(headers))
+	if len(headers) != 0 {
+		data, err := json.Marshal(headers)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal(data, &decoded); err != nil {
+			return nil, err
+		}
+	}
+
+	for name, value := range decoded {
+		s, ok := value.(string)
+		if !ok || schema.Properties[name] == nil || schema.Properties[name].Value == nil {
+			continue
+		}
+
+		switch typ := schema.Properties[name].Value.Type; {
+		case typ.Is(openapi3.TypeInteger), typ.Is(openapi3.TypeNumber):
+			if v, err := strconv.ParseFloat(s, 64); err == nil {
+				decoded[name] = v
+			}
+		case typ.Is(openapi3.TypeBoolean):
+			if v, err := strconv.ParseBool(s); err == nil {
+				decoded[name] = v
+			}
+		}
+	}
+
+	return decoded, nil
+}
@@ -0,0 +1,209 @@
+package msgfilter
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/rdmrcv/go-asyncapi2/spec"
+	"github.com/rdmrcv/go-asyncapi2/spec/validate"
+)
+
+const ordersAPI = `
+asyncapi: 2.0.0
+info:
+  title: Orders API
+  version: 1.0.0
+defaultContentType: application/json
+channels:
+  orders:
+    subscribe:
+      message:
+        oneOf:
+          - $ref: '#/components/messages/orderCreated'
+          - $ref: '#/components/messages/orderCancelled'
+    publish:
+      message:
+        name: note
+        contentType: text/plain
+        payload:
+          type: string
+          maxLength: 5
+components:
+  messages:
+    orderCreated:
+      name: orderCreated
+      payload:
+        type: object
+        required: [id]
+        properties:
+          id:
+            type: integer
+      traits:
+        - $ref: '#/components/messageTraits/versioned'
+    orderCancelled:
+      name: orderCancelled
+      payload:
+        type: object
+        required: [reason]
+        properties:
+          reason:
+            type: string
+  messageTraits:
+    versioned:
+      headers:
+        type: object
+        required: [version]
+        properties:
+          version:
+            type: integer
+`
+
+func TestValidateMessage(t *testing.T) {
+	doc, err := spec.NewLoader().LoadFromData([]byte(ordersAPI))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	validateMessage := func(direction Direction, message *Message) (*spec.Message, error) {
+		return ValidateMessage(context.Background(), &MessageValidationInput{
+			Doc:       doc,
+			Channel:   "orders",
+			Direction: direction,
+			Message:   message,
+		})
+	}
+
+	matched, err := validateMessage(Subscribe, &Message{
+		Headers: map[string]interface{}{"version": "2"},
+		Payload: []byte(`{"id": 1}`),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if matched.Name != "orderCreated" {
+		t.Fatalf("expected orderCreated, got %q", matched.Name)
+	}
+
+	matched, err = validateMessage(Subscribe, &Message{
+		ContentType: "application/json; charset=utf-8",
+		Payload:     []byte(`{"reason": "out of stock"}`),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if matched.Name != "orderCancelled" {
+		t.Fatalf("expected orderCancelled, got %q", matched.Name)
+	}
+
+	_, err = validateMessage(Subscribe, &Message{Payload: []byte(`{"id": 1}`)})
+
+	var noMatch *NoMatchingMessageError
+	if !errors.As(err, &noMatch) || len(noMatch.Errors) != 2 {
+		t.Fatalf("expected NoMatchingMessageError with 2 errors, got %v", err)
+	}
+
+	if reason := noMatch.Errors[0].Reason; reason != ReasonHeaders {
+		t.Fatalf("expected %q, got %q", ReasonHeaders, reason)
+	}
+
+	if _, err := validateMessage(Publish, &Message{Payload: []byte("hello")}); err != nil {
+		t.Fatal(err)
+	}
+
+	var messageErr *MessageError
+
+	_, err = validateMessage(Publish, &Message{Payload: []byte("hello world")})
+	if !errors.As(err, &messageErr) || messageErr.Reason != ReasonPayload {
+		t.Fatalf("expected payload error, got %v", err)
+	}
+
+	_, err = validateMessage(Publish, &Message{ContentType: "application/json", Payload: []byte(`"hi"`)})
+	if !errors.As(err, &messageErr) || messageErr.Reason != ReasonContentType {
+		t.Fatalf("expected content type error, got %v", err)
+	}
+
+	_, err = ValidateMessage(context.Background(), &MessageValidationInput{Doc: doc, Channel: "users", Direction: Publish})
+	if !errors.Is(err, ErrChannelNotFound) {
+		t.Fatalf("expected ErrChannelNotFound, got %v", err)
+	}
+}
+
+func TestValidateMessage_ContextOptions(t *testing.T) {
+	doc, err := spec.NewLoader().LoadFromData([]byte(`
+asyncapi: 2.0.0
+info:
+  title: Notes API
+  version: 1.0.0
+channels:
+  notes:
+    publish:
+      message:
+        contentType: text/plain
+        payload:
+          type: string
+          pattern: '^[a-z]+$'
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	input := &MessageValidationInput{
+		Doc:       doc,
+		Channel:   "notes",
+		Direction: Publish,
+		Message:   &Message{Payload: []byte("Note")},
+	}
+
+	var messageErr *MessageError
+	if _, err := ValidateMessage(context.Background(), input); !errors.As(err, &messageErr) {
+		t.Fatalf("expected MessageError, got %v", err)
+	}
+
+	ctx := validate.WithOptions(context.Background(), validate.DisableSchemaPatternValidation())
+	if _, err := ValidateMessage(ctx, input); err != nil {
+		t.Fatalf("expected pattern validation to be disabled, got %v", err)
+	}
+}
+
+func TestValidateMessage_DefaultContentType(t *testing.T) {
+	doc, err := spec.NewLoader().LoadFromData([]byte(`
+asyncapi: 2.0.0
+info:
+  title: Orders API
+  version: 1.0.0
+channels:
+  orders:
+    publish:
+      message:
+        payload:
+          type: object
+          required: [id]
+          properties:
+            id:
+              type: integer
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	input := &MessageValidationInput{
+		Doc:       doc,
+		Channel:   "orders",
+		Direction: Publish,
+		Message:   &Message{Payload: []byte(`{"id": 42}`)},
+	}
+
+	if _, err := ValidateMessage(context.Background(), input); err != nil {
+		t.Fatalf("expected the payload to be decoded as JSON, got %v", err)
+	}
+
+	input.Message = &Message{Payload: []byte(`{"id": "42"}`)}
+
+	var messageErr *MessageError
+	if _, err := ValidateMessage(context.Background(), input); !errors.As(err, &messageErr) || messageErr.Reason != ReasonPayload {
+		t.Fatalf("expected MessageError of the payload, got %v", err)
+	}
+}
@@ -3,6 +3,9 @@ package spec
 import (
 	"context"
 	"encoding/json"
+	"strconv"
+
+	"github.com/rdmrcv/go-asyncapi2/spec/validate"
 )
 
 type oneOfField struct {
@@ -69,6 +72,10 @@ func (value *MessageOneOf) UnmarshalJSON(data []byte) error {
 			return nil
 		}
 
+		if value.Value == nil {
+			value.Value = &Message{}
+		}
+
 		return json.Unmarshal(data, value.Value)
 	}
 
@@ -85,6 +92,8 @@ func (value *MessageOneOf) UnmarshalJSON(data []byte) error {
 
 		if len(ref.Ref) != 0 {
 			entModel.Ref = ref.Ref
+			entModel.Value = nil
+			value.OneOf = append(value.OneOf, &entModel)
 
 			continue
 		}
@@ -100,17 +109,15 @@ func (value *MessageOneOf) UnmarshalJSON(data []byte) error {
 }
 
 func (value *MessageOneOf) Validate(ctx context.Context) error {
-	if v := value.Value; v != nil {
-		return v.Validate(ctx)
-	}
-
 	if v := value.OneOf; len(v) > 0 {
-		for _, ent := range v {
-			if err := ent.Validate(ctx); err != nil {
+		for i, ent := range v {
+			if err := ent.Validate(validate.At(ctx, "oneOf", strconv.Itoa(i))); err != nil {
 				return err
 			}
 		}
+
+		return nil
 	}
 
-	return foundUnresolvedRef(value.Ref)
+	return value.MessageRef.Validate(ctx)
 }
@@ -3,8 +3,11 @@ package spec
 import (
 	"context"
 	"encoding/json"
+	"strconv"
 
 	"github.com/getkin/kin-openapi/openapi3"
+
+	"github.com/rdmrcv/go-asyncapi2/spec/validate"
 )
 
 type OperationsTraits map[string]*OperationTrait
@@ -74,8 +77,12 @@ func (value *OperationTrait) UnmarshalJSON(data []byte) error {
 }
 
 func (value *OperationTrait) Validate(ctx context.Context) error {
+	if err := validate.Extensions(ctx, value.Extensions); err != nil {
+		return err
+	}
+
 	if v := value.Bindings; v != nil {
-		if err := v.Validate(ctx); err != nil {
+		if err := v.Validate(validate.At(ctx, "bindings")); err != nil {
 			return err
 		}
 	}
@@ -105,32 +112,44 @@ func (value *Operation) MarshalJSON() ([]byte, error) {
 }
 
 func (value *Operation) UnmarshalJSON(data []byte) error {
-	type OperationBis Operation
+	// OperationBis does not embed OperationTrait, otherwise its UnmarshalJSON is promoted and hides own fields.
+	type OperationBis struct {
+		Traits  []*OperationTraitRef `json:"traits,omitempty"`
+		Message *MessageOneOf        `json:"message,omitempty"`
+	}
 	var x OperationBis
 	if err := json.Unmarshal(data, &x); err != nil {
 		return err
 	}
 
-	if err := x.OperationTrait.UnmarshalJSON(data); err != nil {
+	var trait OperationTrait
+	if err := trait.UnmarshalJSON(data); err != nil {
 		return err
 	}
 
-	*value = Operation(x)
+	delete(trait.Extensions, "traits")
+	delete(trait.Extensions, "message")
+
+	*value = Operation{
+		OperationTrait: trait,
+		Traits:         x.Traits,
+		Message:        x.Message,
+	}
 
 	return nil
 }
 
 func (value *Operation) Validate(ctx context.Context) error {
 	if v := value.Traits; len(v) > 0 {
-		for _, item := range v {
-			if err := item.Validate(ctx); err != nil {
+		for i, item := range v {
+			if err := item.Validate(validate.At(ctx, "traits", strconv.Itoa(i))); err != nil {
 				return err
 			}
 		}
 	}
 
 	if v := value.Message; v != nil {
-		if err := v.Validate(ctx); err != nil {
+		if err := v.Validate(validate.At(ctx, "message")); err != nil {
 			return err
 		}
 	}
@@ -3,8 +3,11 @@ package spec
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 
 	"github.com/getkin/kin-openapi/openapi3"
+
+	"github.com/rdmrcv/go-asyncapi2/spec/validate"
 )
 
 type Parameters map[string]*Parameter
@@ -13,8 +16,8 @@ type Parameters map[string]*Parameter
 type ParametersRefs map[string]*ParameterRef
 
 func (h ParametersRefs) Validate(ctx context.Context) error {
-	for _, item := range h {
-		if err := item.Validate(ctx); err != nil {
+	for _, k := range sortedKeys(h) {
+		if err := h[k].Validate(validate.At(ctx, k)); err != nil {
 			return err
 		}
 	}
@@ -68,11 +71,24 @@ func (value *Parameter) UnmarshalJSON(data []byte) error {
 }
 
 func (value *Parameter) Validate(ctx context.Context) error {
+	if err := validate.Extensions(ctx, value.Extensions); err != nil {
+		return err
+	}
+
 	if v := value.Schema; v != nil {
-		if err := v.Validate(ctx); err != nil {
+		if err := validate.Schema(validate.At(ctx, "schema"), v); err != nil {
 			return err
 		}
 	}
 
+	if v := value.Location; v != "" {
+		if _, err := ParseRuntimeExpression(v); err != nil {
+			err = fmt.Errorf("%w: %w", err, validate.ErrWrongField)
+			if err := validate.Report(validate.At(ctx, "location"), validate.RuleValue, err); err != nil {
+				return err
+			}
+		}
+	}
+
 	return nil
 }
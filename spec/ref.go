@@ -3,24 +3,36 @@ package spec
 import (
 	"context"
 	"encoding/json"
+	"reflect"
+
+	"github.com/rdmrcv/go-asyncapi2/spec/validate"
 )
 
 type Ref struct {
 	Ref string `json:"$ref" yaml:"$ref"`
 }
 
-type RefG[V interface {
+type refValue interface {
 	comparable
 
 	json.Marshaler
 	json.Unmarshaler
 
 	Validate(ctx context.Context) error
-}] struct {
+}
+
+type RefG[V refValue] struct {
 	Ref   string
 	Value V
 }
 
+// newRefValue allocates a value the V pointer type points to.
+func newRefValue[V refValue]() V {
+	var zero V
+
+	return reflect.New(reflect.TypeOf(zero).Elem()).Interface().(V)
+}
+
 func (value *RefG[V]) MarshalJSON() ([]byte, error) {
 	if ref := value.Ref; ref != "" {
 		return json.Marshal(Ref{Ref: ref})
@@ -40,6 +52,11 @@ func (value *RefG[V]) UnmarshalJSON(data []byte) error {
 		}
 	}
 
+	var zero V
+	if value.Value == zero {
+		value.Value = newRefValue[V]()
+	}
+
 	return json.Unmarshal(data, value.Value)
 }
 
@@ -47,8 +64,12 @@ func (value *RefG[V]) Validate(ctx context.Context) error {
 	var zero V
 
 	if v := value.Value; v != zero {
+		if !validate.Visit(ctx, v) {
+			return nil
+		}
+
 		return v.Validate(ctx)
 	}
 
-	return foundUnresolvedRef(value.Ref)
+	return validate.Report(ctx, validate.RuleRef, foundUnresolvedRef(value.Ref))
 }
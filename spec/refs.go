@@ -3,16 +3,32 @@ package spec
 import (
 	"errors"
 	"fmt"
+	"strings"
 )
 
 var (
 	ErrUnresolvedRef = errors.New("found unresolved ref")
+	ErrCircularRef   = errors.New("found circular ref")
 )
 
 func foundUnresolvedRef(ref string) error {
 	return fmt.Errorf("%q is not resolved: %w", ref, ErrUnresolvedRef)
 }
 
+// CircularRefError is returned when a ref points to itself through other refs.
+type CircularRefError struct {
+	// Chain lists the refs of the cycle, the first one is repeated at the end.
+	Chain []string
+}
+
+func (e *CircularRefError) Error() string {
+	return fmt.Sprintf("%s: %s", ErrCircularRef, strings.Join(e.Chain, " -> "))
+}
+
+func (e *CircularRefError) Is(target error) bool {
+	return target == ErrCircularRef
+}
+
 type ChannelRef = RefG[*Channel]
 type MessageRef = RefG[*Message]
 type ParameterRef = RefG[*Parameter]
@@ -0,0 +1,139 @@
+package spec
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Sources of values runtime expressions point to.
+const (
+	RuntimeExpressionHeader  = "header"
+	RuntimeExpressionPayload = "payload"
+)
+
+var (
+	ErrInvalidRuntimeExpression = errors.New("invalid runtime expression")
+	ErrRuntimeValueNotFound     = errors.New("value of runtime expression is not found")
+)
+
+const runtimeExpressionPrefix = "$message."
+
+// RuntimeExpression is defined in AsyncAPI spec: https://github.com/asyncapi/spec/blob/2.0.0/versions/2.0.0/asyncapi.md#runtime-expression
+type RuntimeExpression struct {
+	// Source is either RuntimeExpressionHeader or RuntimeExpressionPayload.
+	Source string
+	// Pointer is the JSON pointer to the value in the source, empty for the whole source.
+	Pointer string
+}
+
+// ParseRuntimeExpression parses expressions like "$message.header#/correlationId".
+func ParseRuntimeExpression(expression string) (*RuntimeExpression, error) {
+	if !strings.HasPrefix(expression, runtimeExpressionPrefix) {
+		return nil, fmt.Errorf("%q should start with %q: %w", expression, runtimeExpressionPrefix, ErrInvalidRuntimeExpression)
+	}
+
+	source, pointer, hasFragment := strings.Cut(strings.TrimPrefix(expression, runtimeExpressionPrefix), "#")
+
+	if source != RuntimeExpressionHeader && source != RuntimeExpressionPayload {
+		return nil, fmt.Errorf(
+			"%q should point to either %s or %s: %w",
+			expression,
+			RuntimeExpressionHeader,
+			RuntimeExpressionPayload,
+			ErrInvalidRuntimeExpression,
+		)
+	}
+
+	if hasFragment {
+		if pointer == "" {
+			return nil, fmt.Errorf("%q has an empty JSON pointer after #: %w", expression, ErrInvalidRuntimeExpression)
+		}
+
+		if err := validateJSONPointer(pointer); err != nil {
+			return nil, fmt.Errorf("%q: %w: %w", expression, err, ErrInvalidRuntimeExpression)
+		}
+	}
+
+	return &RuntimeExpression{Source: source, Pointer: pointer}, nil
+}
+
+// validateJSONPointer checks the pointer syntax, see RFC 6901.
+func validateJSONPointer(pointer string) error {
+	if pointer != "" && pointer[0] != '/' {
+		return fmt.Errorf("JSON pointer %q should start with /", pointer)
+	}
+
+	for i := 0; i < len(pointer); i++ {
+		if pointer[i] == '~' && (i+1 == len(pointer) || (pointer[i+1] != '0' && pointer[i+1] != '1')) {
+			return fmt.Errorf("JSON pointer %q has ~ not followed by 0 or 1", pointer)
+		}
+	}
+
+	return nil
+}
+
+func (expression *RuntimeExpression) String() string {
+	s := runtimeExpressionPrefix + expression.Source
+	if expression.Pointer != "" {
+		s += "#" + expression.Pointer
+	}
+
+	return s
+}
+
+// RuntimeMessage is a message runtime expressions are evaluated against.
+//
+// Headers and Payload hold either raw JSON as []byte or json.RawMessage, or decoded values.
+// Values other than decoded by encoding/json into interface{} are converted through JSON.
+type RuntimeMessage struct {
+	Headers interface{}
+	Payload interface{}
+}
+
+// Evaluate returns the value of the message the expression points to.
+func (expression *RuntimeExpression) Evaluate(message RuntimeMessage) (interface{}, error) {
+	source := message.Payload
+	if expression.Source == RuntimeExpressionHeader {
+		source = message.Headers
+	}
+
+	node, err := decodeRuntimeValue(source)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", expression, err)
+	}
+
+	value, ok := lookupJSONPointer(node, parseJSONPointer(expression.Pointer))
+	if !ok {
+		return nil, fmt.Errorf("%s: %w", expression, ErrRuntimeValueNotFound)
+	}
+
+	return value, nil
+}
+
+// decodeRuntimeValue returns the value as a tree of maps and slices the JSON pointer can walk.
+func decodeRuntimeValue(value interface{}) (interface{}, error) {
+	var data []byte
+
+	switch v := value.(type) {
+	case nil, bool, float64, string, map[string]interface{}, []interface{}:
+		return v, nil
+	case json.RawMessage:
+		data = v
+	case []byte:
+		data = v
+	default:
+		var err error
+		if data, err = json.Marshal(v); err != nil {
+			return nil, err
+		}
+	}
+
+	var node interface{}
+	if err := json.Unmarshal(data, &node); err != nil {
+		return nil, err
+	}
+
+	return node, nil
+}
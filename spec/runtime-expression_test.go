@@ -0,0 +1,80 @@
+package spec
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseRuntimeExpression(t *testing.T) {
+	tests := []struct {
+		expression string
+		want       *RuntimeExpression
+	}{
+		{"$message.header#/correlationId", &RuntimeExpression{Source: RuntimeExpressionHeader, Pointer: "/correlationId"}},
+		{"$message.payload#/user/id", &RuntimeExpression{Source: RuntimeExpressionPayload, Pointer: "/user/id"}},
+		{"$message.payload", &RuntimeExpression{Source: RuntimeExpressionPayload}},
+		{"$message.payload#/a~1b/c~0d", &RuntimeExpression{Source: RuntimeExpressionPayload, Pointer: "/a~1b/c~0d"}},
+		{"$message.body#/id", nil},
+		{"$request.header#/id", nil},
+		{"$message.header#", nil},
+		{"$message.header#id", nil},
+		{"$message.header#/a~2", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expression, func(t *testing.T) {
+			got, err := ParseRuntimeExpression(tt.expression)
+			if tt.want == nil {
+				if !errors.Is(err, ErrInvalidRuntimeExpression) {
+					t.Fatalf("expected ErrInvalidRuntimeExpression, got %v", err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if *got != *tt.want {
+				t.Fatalf("expected %+v, got %+v", tt.want, got)
+			}
+
+			if got.String() != tt.expression {
+				t.Fatalf("expected %q, got %q", tt.expression, got.String())
+			}
+		})
+	}
+}
+
+func TestCorrelationID_Evaluate(t *testing.T) {
+	message := RuntimeMessage{
+		Headers: map[string]string{"correlationId": "abc"},
+		Payload: []byte(`{"user": {"id": 42, "roles": ["admin"]}}`),
+	}
+
+	tests := []struct {
+		location string
+		want     interface{}
+	}{
+		{"$message.header#/correlationId", "abc"},
+		{"$message.payload#/user/id", float64(42)},
+		{"$message.payload#/user/roles/0", "admin"},
+	}
+
+	for _, tt := range tests {
+		got, err := (&CorrelationID{Location: tt.location}).Evaluate(message)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if got != tt.want {
+			t.Fatalf("%s: expected %v, got %v", tt.location, tt.want, got)
+		}
+	}
+
+	_, err := (&CorrelationID{Location: "$message.payload#/user/name"}).Evaluate(message)
+	if !errors.Is(err, ErrRuntimeValueNotFound) {
+		t.Fatalf("expected ErrRuntimeValueNotFound, got %v", err)
+	}
+}
@@ -0,0 +1,103 @@
+package spec
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/rdmrcv/go-asyncapi2/spec/validate"
+)
+
+// validateSecurityRequirements checks that security requirements of servers name declared security schemes
+// and list scopes only of oauth2 and openIdConnect schemes, known ones for oauth2.
+func (doc *T) validateSecurityRequirements(ctx context.Context) error {
+	var schemes SecuritySchemes
+	if doc.Components != nil {
+		schemes = doc.Components.SecuritySchemes
+	}
+
+	for _, k := range sortedKeys(doc.Servers) {
+		server := doc.Servers[k]
+		if server == nil {
+			continue
+		}
+
+		for i, requirement := range server.Security {
+			ctx := validate.At(ctx, "servers", k, "security", strconv.Itoa(i))
+
+			for _, name := range sortedKeys(requirement) {
+				if err := validateSecurityRequirement(validate.At(ctx, name), k, schemes[name], name, requirement[name]); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateSecurityRequirement(ctx context.Context, server string, scheme *SecurityScheme, name string, scopes []string) error {
+	if scheme == nil {
+		err := fmt.Errorf("server %q: security scheme %q is not declared: %w", server, name, validate.ErrWrongField)
+
+		return validate.Report(ctx, validate.RuleRef, err)
+	}
+
+	switch scheme.Type {
+	case "oauth2":
+		known := scheme.Flows.scopes()
+
+		for i, scope := range scopes {
+			if _, ok := known[scope]; ok {
+				continue
+			}
+
+			err := fmt.Errorf(
+				"server %q: scope %q is not declared by flows of security scheme %q: %w",
+				server,
+				scope,
+				name,
+				validate.ErrWrongField,
+			)
+			if err := validate.Report(validate.At(ctx, strconv.Itoa(i)), validate.RuleValue, err); err != nil {
+				return err
+			}
+		}
+	case "openIdConnect":
+		// Scopes of OpenID Connect schemes are known only to the provider.
+	default:
+		if len(scopes) != 0 {
+			err := fmt.Errorf(
+				"server %q: security scheme %q of type %q can not have scopes, only oauth2 and openIdConnect ones can: %w",
+				server,
+				name,
+				scheme.Type,
+				validate.ErrWrongField,
+			)
+
+			return validate.Report(ctx, validate.RuleValue, err)
+		}
+	}
+
+	return nil
+}
+
+// scopes returns scopes declared by all flows.
+func (value *OAuthFlows) scopes() map[string]struct{} {
+	scopes := make(map[string]struct{})
+	if value == nil {
+		return scopes
+	}
+
+	for _, flow := range []*OAuthFlowObject{value.Implicit, value.Password, value.ClientCredentials, value.AuthorizationCode} {
+		if flow == nil {
+			continue
+		}
+
+		for scope := range flow.Scopes {
+			scopes[scope] = struct{}{}
+		}
+	}
+
+	return scopes
+}
@@ -0,0 +1,69 @@
+package spec
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/rdmrcv/go-asyncapi2/spec/validate"
+)
+
+func TestT_Validate_SecurityRequirements(t *testing.T) {
+	doc, err := NewLoader().LoadFromData([]byte(`
+asyncapi: 2.0.0
+info:
+  title: Orders API
+  version: 1.0.0
+servers:
+  production:
+    url: broker.example.com
+    protocol: mqtt
+    security:
+      - user: []
+        oauth: [orders:read, orders:delete]
+        oidc: [profile]
+      - user: [admin]
+        token: []
+components:
+  securitySchemes:
+    user:
+      type: userPassword
+    oidc:
+      type: openIdConnect
+      openIdConnectUrl: https://auth.example.com/.well-known/openid-configuration
+    oauth:
+      type: oauth2
+      flows:
+        clientCredentials:
+          tokenUrl: https://auth.example.com/token
+          scopes:
+            orders:read: Read orders
+            orders:write: Write orders
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = doc.ValidateAll(context.Background())
+
+	var errs validate.Errors
+	if !errors.As(err, &errs) {
+		t.Fatalf("expected validate.Errors, got %v", err)
+	}
+
+	want := []validate.Error{
+		{Pointer: "/servers/production/security/0/oauth/1", Rule: validate.RuleValue},
+		{Pointer: "/servers/production/security/1/token", Rule: validate.RuleRef},
+		{Pointer: "/servers/production/security/1/user", Rule: validate.RuleValue},
+	}
+
+	if len(errs) != len(want) {
+		t.Fatalf("expected %d errors, got %d:\n%v", len(want), len(errs), err)
+	}
+
+	for i, v := range want {
+		if errs[i].Pointer != v.Pointer || errs[i].Rule != v.Rule {
+			t.Errorf("expected %s error at %s, got %s error at %s", v.Rule, v.Pointer, errs[i].Rule, errs[i].Pointer)
+		}
+	}
+}
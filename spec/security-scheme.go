@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/rdmrcv/go-asyncapi2/spec/validate"
 )
@@ -61,16 +62,34 @@ func (value *OAuthFlowObject) UnmarshalJSON(data []byte) error {
 }
 
 func (value *OAuthFlowObject) Validate(ctx context.Context) error {
-	if len(value.AuthorizationUrl) == 0 {
-		return fmt.Errorf("field \"authorizationUrl\" is required: %w", validate.ErrWrongField)
+	return value.validate(ctx, true, true)
+}
+
+// validate checks the flow, which URLs are required depends on the kind of the flow.
+func (value *OAuthFlowObject) validate(ctx context.Context, authorizationURLRequired, tokenURLRequired bool) error {
+	if err := validate.Extensions(ctx, value.Extensions); err != nil {
+		return err
+	}
+
+	if authorizationURLRequired && len(value.AuthorizationUrl) == 0 {
+		err := fmt.Errorf("field \"authorizationUrl\" is required: %w", validate.ErrWrongField)
+		if err := validate.Report(validate.At(ctx, "authorizationUrl"), validate.RuleRequired, err); err != nil {
+			return err
+		}
 	}
 
-	if len(value.TokenUrl) == 0 {
-		return fmt.Errorf("field \"tokenUrl\" is required: %w", validate.ErrWrongField)
+	if tokenURLRequired && len(value.TokenUrl) == 0 {
+		err := fmt.Errorf("field \"tokenUrl\" is required: %w", validate.ErrWrongField)
+		if err := validate.Report(validate.At(ctx, "tokenUrl"), validate.RuleRequired, err); err != nil {
+			return err
+		}
 	}
 
 	if value.Scopes == nil {
-		return fmt.Errorf("field \"scopes\" is required: %w", validate.ErrWrongField)
+		err := fmt.Errorf("field \"scopes\" is required: %w", validate.ErrWrongField)
+		if err := validate.Report(validate.At(ctx, "scopes"), validate.RuleRequired, err); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -127,91 +146,101 @@ func (value *OAuthFlows) UnmarshalJSON(data []byte) error {
 }
 
 func (value *OAuthFlows) Validate(ctx context.Context) error {
-	if v := value.Implicit; v != nil {
-		return value.Implicit.Validate(ctx)
+	if err := validate.Extensions(ctx, value.Extensions); err != nil {
+		return err
 	}
 
-	if v := value.Password; v != nil {
-		return value.Implicit.Validate(ctx)
+	flows := []struct {
+		name string
+		flow *OAuthFlowObject
+		// authorizationURL and tokenURL tell whether the flow requires these URLs.
+		authorizationURL, tokenURL bool
+	}{
+		{"implicit", value.Implicit, true, false},
+		{"password", value.Password, false, true},
+		{"clientCredentials", value.ClientCredentials, false, true},
+		{"authorizationCode", value.AuthorizationCode, true, true},
 	}
 
-	if v := value.ClientCredentials; v != nil {
-		return value.Implicit.Validate(ctx)
-	}
+	for _, v := range flows {
+		if v.flow == nil {
+			continue
+		}
 
-	if v := value.AuthorizationCode; v != nil {
-		return value.Implicit.Validate(ctx)
+		if err := v.flow.validate(validate.At(ctx, v.name), v.authorizationURL, v.tokenURL); err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
 
-var validationsByType = map[string]func(value *SecurityScheme) error{
-	"userPassword": func(value *SecurityScheme) error {
-		return nil
-	},
-	"X509": func(value *SecurityScheme) error {
-		return nil
-	},
-	"symmetricEncryption": func(value *SecurityScheme) error {
-		return nil
-	},
-	"asymmetricEncryption": func(value *SecurityScheme) error {
-		return nil
-	},
-	"plain": func(value *SecurityScheme) error {
-		return nil
-	},
-	"scramSha256": func(value *SecurityScheme) error {
-		return nil
-	},
-	"scramSha512": func(value *SecurityScheme) error {
-		return nil
-	},
-	"gssapi": func(value *SecurityScheme) error {
-		return nil
-	},
-	"httpApiKey": func(value *SecurityScheme) error {
-		if len(value.Name) == 0 {
-			return fmt.Errorf("field \"name\" is required: %w", validate.ErrWrongField)
-		}
-		if len(value.In) == 0 {
-			return fmt.Errorf("field \"in\" is required: %w", validate.ErrWrongField)
+var validationsByType = map[string]func(ctx context.Context, value *SecurityScheme) error{
+	"userPassword":         nil,
+	"X509":                 nil,
+	"symmetricEncryption":  nil,
+	"asymmetricEncryption": nil,
+	"plain":                nil,
+	"scramSha256":          nil,
+	"scramSha512":          nil,
+	"gssapi":               nil,
+	"httpApiKey": func(ctx context.Context, value *SecurityScheme) error {
+		if err := requireSchemeField(ctx, "name", value.Name); err != nil {
+			return err
 		}
 
-		return nil
+		return validateSchemeIn(ctx, value.In, "query", "header", "cookie")
 	},
-	"apiKey": func(value *SecurityScheme) error {
-		if len(value.In) == 0 {
-			return fmt.Errorf("field \"in\" is required: %w", validate.ErrWrongField)
-		}
-
-		return nil
+	"apiKey": func(ctx context.Context, value *SecurityScheme) error {
+		return validateSchemeIn(ctx, value.In, "user", "password")
 	},
-	"http": func(value *SecurityScheme) error {
-		if len(value.Scheme) == 0 {
-			return fmt.Errorf("field \"scheme\" is required: %w", validate.ErrWrongField)
-		}
-		if len(value.BearerFormat) == 0 {
-			return fmt.Errorf("field \"bearerFormat\" is required: %w", validate.ErrWrongField)
+	"http": func(ctx context.Context, value *SecurityScheme) error {
+		if err := requireSchemeField(ctx, "scheme", value.Scheme); err != nil {
+			return err
 		}
 
-		return nil
+		return requireSchemeField(ctx, "bearerFormat", value.BearerFormat)
 	},
-	"oauth2": func(value *SecurityScheme) error {
+	"oauth2": func(ctx context.Context, value *SecurityScheme) error {
 		if value.Flows == nil {
-			return fmt.Errorf("field \"flows\" is required: %w", validate.ErrWrongField)
+			err := fmt.Errorf("field \"flows\" is required: %w", validate.ErrWrongField)
+
+			return validate.Report(validate.At(ctx, "flows"), validate.RuleRequired, err)
 		}
 
-		return nil
+		return value.Flows.Validate(validate.At(ctx, "flows"))
 	},
-	"openIdConnect": func(value *SecurityScheme) error {
-		if len(value.OpenIDConnectUrl) != 0 {
-			return fmt.Errorf("field \"openIdConnectUrl\" is required: %w", validate.ErrWrongField)
-		}
+	"openIdConnect": func(ctx context.Context, value *SecurityScheme) error {
+		return requireSchemeField(ctx, "openIdConnectUrl", value.OpenIDConnectUrl)
+	},
+}
 
+// requireSchemeField reports the field of the security scheme when it is empty.
+func requireSchemeField(ctx context.Context, name, value string) error {
+	if len(value) != 0 {
 		return nil
-	},
+	}
+
+	err := fmt.Errorf("field %q is required: %w", name, validate.ErrWrongField)
+
+	return validate.Report(validate.At(ctx, name), validate.RuleRequired, err)
+}
+
+// validateSchemeIn reports the in field of the security scheme when it is empty or not one of the allowed values.
+func validateSchemeIn(ctx context.Context, in string, allowed ...string) error {
+	if len(in) == 0 {
+		return requireSchemeField(ctx, "in", in)
+	}
+
+	for _, v := range allowed {
+		if in == v {
+			return nil
+		}
+	}
+
+	err := fmt.Errorf("field \"in\" must be one of %s: %w", strings.Join(allowed, ", "), validate.ErrWrongField)
+
+	return validate.Report(validate.At(ctx, "in"), validate.RuleValue, err)
 }
 
 // SecurityScheme is defined in AsyncAPI spec: https://github.com/asyncapi/spec/blob/2.0.0/versions/2.0.0/asyncapi.md#security-scheme-object
@@ -283,14 +312,26 @@ func (value *SecurityScheme) UnmarshalJSON(data []byte) error {
 }
 
 func (value *SecurityScheme) Validate(ctx context.Context) error {
+	if err := validate.Extensions(ctx, value.Extensions); err != nil {
+		return err
+	}
+
 	if len(value.Type) == 0 {
-		return fmt.Errorf("field \"type\" is required: %w", validate.ErrWrongField)
+		err := fmt.Errorf("field \"type\" is required: %w", validate.ErrWrongField)
+
+		return validate.Report(validate.At(ctx, "type"), validate.RuleRequired, err)
 	}
 
 	validator, has := validationsByType[value.Type]
 	if !has {
-		return fmt.Errorf("field \"type\" is not expected: %w", validate.ErrWrongField)
+		err := fmt.Errorf("field \"type\" is not expected: %w", validate.ErrWrongField)
+
+		return validate.Report(validate.At(ctx, "type"), validate.RuleValue, err)
+	}
+
+	if validator == nil {
+		return nil
 	}
 
-	return validator(value)
+	return validator(ctx, value)
 }
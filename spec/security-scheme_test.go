@@ -0,0 +1,148 @@
+package spec
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/rdmrcv/go-asyncapi2/spec/validate"
+)
+
+func TestSecurityScheme_Validate(t *testing.T) {
+	tests := []struct {
+		name   string
+		scheme *SecurityScheme
+		want   []validate.Error
+	}{
+		{
+			name:   "user password",
+			scheme: &SecurityScheme{Type: "userPassword"},
+		},
+		{
+			name:   "missing type",
+			scheme: &SecurityScheme{},
+			want:   []validate.Error{{Pointer: "/type", Rule: validate.RuleRequired}},
+		},
+		{
+			name:   "unknown type",
+			scheme: &SecurityScheme{Type: "password"},
+			want:   []validate.Error{{Pointer: "/type", Rule: validate.RuleValue}},
+		},
+		{
+			name:   "http api key",
+			scheme: &SecurityScheme{Type: "httpApiKey", In: "body"},
+			want: []validate.Error{
+				{Pointer: "/name", Rule: validate.RuleRequired},
+				{Pointer: "/in", Rule: validate.RuleValue},
+			},
+		},
+		{
+			name:   "api key",
+			scheme: &SecurityScheme{Type: "apiKey"},
+			want:   []validate.Error{{Pointer: "/in", Rule: validate.RuleRequired}},
+		},
+		{
+			name:   "http",
+			scheme: &SecurityScheme{Type: "http", Scheme: "bearer"},
+			want:   []validate.Error{{Pointer: "/bearerFormat", Rule: validate.RuleRequired}},
+		},
+		{
+			name:   "oauth2 without flows",
+			scheme: &SecurityScheme{Type: "oauth2"},
+			want:   []validate.Error{{Pointer: "/flows", Rule: validate.RuleRequired}},
+		},
+		{
+			name: "oauth2 flows",
+			scheme: &SecurityScheme{Type: "oauth2", Flows: &OAuthFlows{
+				Implicit:          &OAuthFlowObject{Scopes: map[string]string{}},
+				ClientCredentials: &OAuthFlowObject{TokenUrl: "https://auth.example.com/token"},
+			}},
+			want: []validate.Error{
+				{Pointer: "/flows/implicit/authorizationUrl", Rule: validate.RuleRequired},
+				{Pointer: "/flows/clientCredentials/scopes", Rule: validate.RuleRequired},
+			},
+		},
+		{
+			name:   "openIdConnect",
+			scheme: &SecurityScheme{Type: "openIdConnect"},
+			want:   []validate.Error{{Pointer: "/openIdConnectUrl", Rule: validate.RuleRequired}},
+		},
+		{
+			name:   "openIdConnect with url",
+			scheme: &SecurityScheme{Type: "openIdConnect", OpenIDConnectUrl: "https://auth.example.com/.well-known"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validate.Collect(context.Background(), tt.scheme.Validate)
+
+			if len(tt.want) == 0 {
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				return
+			}
+
+			var errs validate.Errors
+			if !errors.As(err, &errs) || len(errs) != len(tt.want) {
+				t.Fatalf("expected %d errors, got %v", len(tt.want), err)
+			}
+
+			for i, v := range tt.want {
+				if errs[i].Pointer != v.Pointer || errs[i].Rule != v.Rule {
+					t.Errorf("expected %s error at %s, got %s error at %s", v.Rule, v.Pointer, errs[i].Rule, errs[i].Pointer)
+				}
+			}
+		})
+	}
+}
+
+func TestOAuthFlows_Validate(t *testing.T) {
+	scopes := map[string]string{"orders:read": "Read orders"}
+
+	tests := []struct {
+		name    string
+		flows   *OAuthFlows
+		wantErr bool
+	}{
+		{
+			name:  "implicit without tokenUrl",
+			flows: &OAuthFlows{Implicit: &OAuthFlowObject{AuthorizationUrl: "https://auth.example.com/authorize", Scopes: scopes}},
+		},
+		{
+			name: "password and client credentials without authorizationUrl",
+			flows: &OAuthFlows{
+				Password:          &OAuthFlowObject{TokenUrl: "https://auth.example.com/token", Scopes: scopes},
+				ClientCredentials: &OAuthFlowObject{TokenUrl: "https://auth.example.com/token", Scopes: scopes},
+			},
+		},
+		{
+			name:    "password without tokenUrl",
+			flows:   &OAuthFlows{Password: &OAuthFlowObject{Scopes: scopes}},
+			wantErr: true,
+		},
+		{
+			name:    "authorization code without tokenUrl",
+			flows:   &OAuthFlows{AuthorizationCode: &OAuthFlowObject{AuthorizationUrl: "https://auth.example.com/authorize", Scopes: scopes}},
+			wantErr: true,
+		},
+		{
+			name: "client credentials without scopes",
+			flows: &OAuthFlows{
+				Implicit:          &OAuthFlowObject{AuthorizationUrl: "https://auth.example.com/authorize", Scopes: scopes},
+				ClientCredentials: &OAuthFlowObject{TokenUrl: "https://auth.example.com/token"},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.flows.Validate(context.Background()); (err != nil) != tt.wantErr {
+				t.Errorf("expected error %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,163 @@
+package spec
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+)
+
+var ErrInvalidServerVariable = errors.New("invalid server variable")
+
+// serverSchemes are URL schemes of protocols whose names are not URL schemes.
+var serverSchemes = map[string]string{
+	"secure-mqtt": "mqtts",
+}
+
+// serverHostLists are protocols whose servers are lists of brokers rather than URLs.
+var serverHostLists = map[string]bool{
+	"kafka":        true,
+	"kafka-secure": true,
+}
+
+// ServerAddress is the expanded url of a server.
+type ServerAddress struct {
+	// URL is the url of the server with the scheme of its protocol.
+	// It is nil for protocols connecting to lists of brokers, like kafka.
+	URL *url.URL
+	// Hosts lists host or host:port pairs of the server.
+	Hosts []string
+}
+
+// ExpandURL replaces variables in the url with the values, or their defaults when values have none.
+// Values not listed in enums of variables are rejected.
+func (value *Server) ExpandURL(values map[string]string) (string, error) {
+	names, err := value.ParameterNames()
+	if err != nil {
+		return "", err
+	}
+
+	resolved := make(map[string]string, len(names))
+
+	for _, name := range names {
+		variable := value.Variables[name]
+
+		v, ok := values[name]
+		if !ok {
+			if variable == nil || variable.Default == "" {
+				return "", fmt.Errorf("variable %q has no value: %w", name, ErrInvalidServerVariable)
+			}
+
+			v = variable.Default
+		}
+
+		if variable != nil && len(variable.Enum) != 0 && !containsString(variable.Enum, v) {
+			return "", fmt.Errorf(
+				"value %q of variable %q should be one of %q: %w",
+				v, name, variable.Enum, ErrInvalidServerVariable,
+			)
+		}
+
+		resolved[name] = v
+	}
+
+	for _, name := range sortedKeys(values) {
+		if _, ok := resolved[name]; !ok {
+			return "", fmt.Errorf("variable %q is not used in the url: %w", name, ErrInvalidServerVariable)
+		}
+	}
+
+	var expanded strings.Builder
+
+	for rest := value.URL; rest != ""; {
+		opening := strings.IndexByte(rest, '{')
+		if opening < 0 {
+			expanded.WriteString(rest)
+			break
+		}
+
+		closing := strings.IndexByte(rest, '}')
+		expanded.WriteString(rest[:opening])
+		expanded.WriteString(resolved[strings.TrimSpace(rest[opening+1:closing])])
+		rest = rest[closing+1:]
+	}
+
+	return expanded.String(), nil
+}
+
+// Address expands the url with the values, see ExpandURL, and parses it according to the protocol.
+// Servers of kafka are comma separated lists of hosts, others are URLs with the protocol as the scheme
+// when the url has none.
+func (value *Server) Address(values map[string]string) (*ServerAddress, error) {
+	expanded, err := value.ExpandURL(values)
+	if err != nil {
+		return nil, err
+	}
+
+	protocol := strings.ToLower(value.Protocol)
+
+	if serverHostLists[protocol] {
+		address := &ServerAddress{}
+
+		for _, host := range strings.Split(expanded, ",") {
+			host = strings.TrimSpace(host)
+			if i := strings.Index(host, "://"); i >= 0 {
+				host = host[i+3:]
+			}
+			host = strings.TrimSuffix(host, "/")
+
+			if err := validateServerHost(host); err != nil {
+				return nil, err
+			}
+
+			address.Hosts = append(address.Hosts, host)
+		}
+
+		return address, nil
+	}
+
+	if !strings.Contains(expanded, "://") {
+		scheme, ok := serverSchemes[protocol]
+		if !ok {
+			scheme = protocol
+		}
+
+		expanded = scheme + "://" + expanded
+	}
+
+	parsed, err := url.Parse(expanded)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateServerHost(parsed.Host); err != nil {
+		return nil, err
+	}
+
+	return &ServerAddress{URL: parsed, Hosts: []string{parsed.Host}}, nil
+}
+
+func validateServerHost(host string) error {
+	if host == "" {
+		return errors.New("server host is empty")
+	}
+
+	if strings.LastIndexByte(host, ':') > strings.LastIndexByte(host, ']') {
+		if _, _, err := net.SplitHostPort(host); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
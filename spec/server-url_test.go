@@ -0,0 +1,93 @@
+package spec
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestServer_Address(t *testing.T) {
+	variables := map[string]*ServerVariable{
+		"env":  {Enum: []string{"dev", "prod"}, Default: "dev"},
+		"port": {Default: "1883"},
+	}
+
+	tests := []struct {
+		name   string
+		server *Server
+		values map[string]string
+		url    string
+		hosts  []string
+		err    error
+	}{
+		{
+			name:   "defaults",
+			server: &Server{URL: "{env}.example.com:{port}", Protocol: "secure-mqtt", Variables: variables},
+			url:    "mqtts://dev.example.com:1883",
+			hosts:  []string{"dev.example.com:1883"},
+		},
+		{
+			name:   "values",
+			server: &Server{URL: "ws://{env}.example.com:{port}/ws", Protocol: "ws", Variables: variables},
+			values: map[string]string{"env": "prod", "port": "8080"},
+			url:    "ws://prod.example.com:8080/ws",
+			hosts:  []string{"prod.example.com:8080"},
+		},
+		{
+			name:   "not in enum",
+			server: &Server{URL: "{env}.example.com:{port}", Protocol: "mqtt", Variables: variables},
+			values: map[string]string{"env": "stage"},
+			err:    ErrInvalidServerVariable,
+		},
+		{
+			name:   "unknown variable",
+			server: &Server{URL: "{env}.example.com", Protocol: "mqtt", Variables: variables},
+			values: map[string]string{"port": "1"},
+			err:    ErrInvalidServerVariable,
+		},
+		{
+			name:   "no value",
+			server: &Server{URL: "{host}:1883", Protocol: "mqtt", Variables: map[string]*ServerVariable{"host": {}}},
+			err:    ErrInvalidServerVariable,
+		},
+		{
+			name: "kafka",
+			server: &Server{
+				URL:       "kafka-1.{env}.example.com:9092, kafka://kafka-2.{env}.example.com:9092",
+				Protocol:  "kafka",
+				Variables: variables,
+			},
+			values: map[string]string{"env": "prod"},
+			hosts:  []string{"kafka-1.prod.example.com:9092", "kafka-2.prod.example.com:9092"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			address, err := tt.server.Address(tt.values)
+			if tt.err != nil {
+				if !errors.Is(err, tt.err) {
+					t.Fatalf("expected %v, got %v", tt.err, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if tt.url == "" && address.URL != nil {
+				t.Errorf("expected no url, got %s", address.URL)
+			}
+
+			if tt.url != "" && (address.URL == nil || address.URL.String() != tt.url) {
+				t.Errorf("expected %s, got %v", tt.url, address.URL)
+			}
+
+			if !reflect.DeepEqual(address.Hosts, tt.hosts) {
+				t.Errorf("expected %v, got %v", tt.hosts, address.Hosts)
+			}
+		})
+	}
+}
@@ -9,6 +9,8 @@ import (
 	"net/url"
 	"regexp"
 	"strings"
+
+	"github.com/rdmrcv/go-asyncapi2/spec/validate"
 )
 
 var (
@@ -21,12 +23,17 @@ var (
 type Servers map[string]*Server
 
 func (servers Servers) Validate(ctx context.Context) error {
-	for k, v := range servers {
+	for _, k := range sortedKeys(servers) {
+		ctx := validate.At(ctx, k)
+
 		if !serverKeyRegexp.MatchString(k) {
-			return ErrServerKeyInvalid
+			err := fmt.Errorf("%w: %w", ErrServerKeyInvalid, validate.ErrWrongField)
+			if err := validate.Report(ctx, validate.RuleIdentifier, err); err != nil {
+				return err
+			}
 		}
 
-		if err := v.Validate(ctx); err != nil {
+		if err := servers[k].Validate(ctx); err != nil {
 			return err
 		}
 	}
@@ -184,37 +191,53 @@ func (value *Server) MatchRawURL(input string) ([]string, string, bool) {
 	return params, input, true
 }
 
-func (value *Server) Validate(ctx context.Context) (err error) {
+func (value *Server) Validate(ctx context.Context) error {
+	if err := validate.Extensions(ctx, value.Extensions); err != nil {
+		return err
+	}
+
 	if value.URL == "" {
-		return errors.New("value of url must be a non-empty string")
+		err := fmt.Errorf("value of url must be a non-empty string: %w", validate.ErrWrongField)
+		if err := validate.Report(validate.At(ctx, "url"), validate.RuleRequired, err); err != nil {
+			return err
+		}
+	} else if err := validate.Report(validate.At(ctx, "url"), validate.RuleValue, value.validateURL()); err != nil {
+		return err
 	}
 
+	for _, name := range sortedKeys(value.Variables) {
+		if err := value.Variables[name].Validate(validate.At(ctx, "variables", name)); err != nil {
+			return err
+		}
+	}
+
+	if v := value.Bindings; v != nil {
+		if err := v.Validate(validate.At(ctx, "bindings")); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateURL checks that variables of the url are declared.
+func (value *Server) validateURL() error {
 	opening, closing := strings.Count(value.URL, "{"), strings.Count(value.URL, "}")
 	if opening != closing {
-		return errors.New("server URL has mismatched { and }")
+		return fmt.Errorf("server URL has mismatched { and }: %w", validate.ErrWrongField)
 	}
 
 	if opening != len(value.Variables) {
-		return errors.New("server has undeclared variables")
+		return fmt.Errorf("server has undeclared variables: %w", validate.ErrWrongField)
 	}
 
-	for name, v := range value.Variables {
+	for name := range value.Variables {
 		if !strings.Contains(value.URL, fmt.Sprintf("{%s}", name)) {
-			return errors.New("server has undeclared variables")
-		}
-
-		if err = v.Validate(ctx); err != nil {
-			return
-		}
-	}
-
-	if v := value.Bindings; v != nil {
-		if err := v.Validate(ctx); err != nil {
-			return err
+			return fmt.Errorf("server has undeclared variables: %w", validate.ErrWrongField)
 		}
 	}
 
-	return
+	return nil
 }
 
 // ServerVariable is defined in AsyncAPI spec: https://github.com/asyncapi/spec/blob/2.0.0/versions/2.0.0/asyncapi.md#serverVariableObject
@@ -262,14 +285,20 @@ func (value *ServerVariable) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
-func (value *ServerVariable) Validate(context.Context) error {
+func (value *ServerVariable) Validate(ctx context.Context) error {
+	if err := validate.Extensions(ctx, value.Extensions); err != nil {
+		return err
+	}
+
 	if value.Default == "" {
 		data, err := value.MarshalJSON()
 		if err != nil {
 			return err
 		}
 
-		return fmt.Errorf("field default is required in %s", data)
+		err = fmt.Errorf("field default is required in %s: %w", data, validate.ErrWrongField)
+
+		return validate.Report(validate.At(ctx, "default"), validate.RuleRequired, err)
 	}
 
 	return nil
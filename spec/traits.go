@@ -0,0 +1,54 @@
+package spec
+
+// ApplyTraits returns the message with its traits merged in.
+//
+// Traits are merged in the order they are listed with JSON Merge Patch semantics,
+// then fields of the message itself are merged over them. The result has no traits
+// and shares values which are not merged with the message and its traits.
+func (message *Message) ApplyTraits() (*Message, error) {
+	trait, err := applyTraits(message.MessageTrait, message.Traits)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Message{
+		MessageTrait: trait,
+		Payload:      message.Payload,
+	}, nil
+}
+
+// ApplyTraits returns the operation with its traits merged in, the same way Message.ApplyTraits does.
+//
+// The message of the operation is kept as is, its traits are not applied.
+func (operation *Operation) ApplyTraits() (*Operation, error) {
+	trait, err := applyTraits(operation.OperationTrait, operation.Traits)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Operation{
+		OperationTrait: trait,
+		Message:        operation.Message,
+	}, nil
+}
+
+func applyTraits[V any, P interface {
+	*V
+	refValue
+}](own V, traits []*RefG[P]) (V, error) {
+	var merged V
+
+	for _, v := range traits {
+		if v == nil {
+			continue
+		}
+
+		if v.Value == nil {
+			return merged, foundUnresolvedRef(v.Ref)
+		}
+
+		merged = mergePatch(merged, *v.Value)
+	}
+
+	return mergePatch(merged, own), nil
+}
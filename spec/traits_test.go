@@ -0,0 +1,121 @@
+package spec
+
+import (
+	"testing"
+)
+
+func TestMessage_ApplyTraits(t *testing.T) {
+	doc, err := NewLoader().LoadFromData([]byte(`
+asyncapi: 2.0.0
+info:
+  title: Orders API
+  version: 1.0.0
+components:
+  messages:
+    orderCreated:
+      name: orderCreated
+      x-owner: orders
+      headers:
+        type: object
+        properties:
+          orderId:
+            type: string
+      bindings:
+        kafka:
+          bindingVersion: 0.1.0
+      traits:
+        - $ref: '#/components/messageTraits/common'
+        - $ref: '#/components/messageTraits/kafka'
+  messageTraits:
+    common:
+      name: common
+      summary: Common message
+      contentType: application/json
+      x-owner: platform
+      x-team: core
+      headers:
+        type: object
+        required: [traceId]
+        properties:
+          traceId:
+            type: string
+    kafka:
+      contentType: application/avro
+      bindings:
+        kafka:
+          key:
+            type: string
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	original := doc.Components.Messages["orderCreated"]
+
+	message, err := original.ApplyTraits()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(message.Traits) != 0 {
+		t.Fatal("traits are kept in the effective message")
+	}
+
+	if message.Name != "orderCreated" || message.Summary != "Common message" {
+		t.Fatalf("unexpected name %q and summary %q", message.Name, message.Summary)
+	}
+
+	if message.ContentType != "application/avro" {
+		t.Fatalf("later trait does not win, got %q", message.ContentType)
+	}
+
+	if message.Extensions["x-owner"] != "orders" || message.Extensions["x-team"] != "core" {
+		t.Fatalf("unexpected extensions %v", message.Extensions)
+	}
+
+	headers := message.Headers.Value
+	if headers.Properties["orderId"] == nil || headers.Properties["traceId"] == nil {
+		t.Fatal("headers are not merged")
+	}
+
+	if len(headers.Required) != 1 || headers.Required[0] != "traceId" {
+		t.Fatalf("unexpected required headers %v", headers.Required)
+	}
+
+	kafka := message.Bindings.Kafka
+	if kafka.Key == nil || kafka.BindingVersion != "0.1.0" {
+		t.Fatal("bindings are not merged")
+	}
+
+	if original.Headers.Value.Properties["traceId"] != nil || original.Bindings.Kafka.Key != nil {
+		t.Fatal("original message is changed")
+	}
+}
+
+func TestOperation_ApplyTraits(t *testing.T) {
+	operation := &Operation{
+		OperationTrait: OperationTrait{OperationID: "sendOrder"},
+		Traits: []*OperationTraitRef{
+			{Value: &OperationTrait{OperationID: "trait", Summary: "Sends an order"}},
+		},
+		Message: &MessageOneOf{MessageRef: MessageRef{Ref: "#/components/messages/order"}},
+	}
+
+	effective, err := operation.ApplyTraits()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if effective.OperationID != "sendOrder" || effective.Summary != "Sends an order" {
+		t.Fatalf("unexpected operation id %q and summary %q", effective.OperationID, effective.Summary)
+	}
+
+	if effective.Message != operation.Message {
+		t.Fatal("message is not kept")
+	}
+
+	operation.Traits = append(operation.Traits, &OperationTraitRef{Ref: "#/components/operationTraits/missing"})
+	if _, err := operation.ApplyTraits(); err == nil {
+		t.Fatal("expected an error for unresolved trait")
+	}
+}
@@ -0,0 +1,140 @@
+package spec
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/rdmrcv/go-asyncapi2/spec/validate"
+)
+
+// uniqueNames groups locations of values by their names. A value found by several refs is counted once,
+// as well as a value found both at its location and by a ref to the location.
+type uniqueNames struct {
+	field     string
+	seen      map[interface{}]struct{}
+	names     []string
+	locations map[string][]context.Context
+}
+
+func newUniqueNames(field string) *uniqueNames {
+	return &uniqueNames{
+		field:     field,
+		seen:      make(map[interface{}]struct{}),
+		locations: make(map[string][]context.Context),
+	}
+}
+
+// add records the name of the value found at the location of the context by the ref, if any.
+func (u *uniqueNames) add(ctx context.Context, name string, value interface{}, ref string) {
+	if name == "" {
+		return
+	}
+
+	keys := []interface{}{value, "#" + validate.Pointer(ctx)}
+	if strings.HasPrefix(ref, "#") {
+		keys = append(keys, ref)
+	}
+
+	for _, k := range keys {
+		if _, ok := u.seen[k]; ok {
+			return
+		}
+	}
+
+	for _, k := range keys {
+		u.seen[k] = struct{}{}
+	}
+
+	if _, ok := u.locations[name]; !ok {
+		u.names = append(u.names, name)
+	}
+
+	u.locations[name] = append(u.locations[name], validate.At(ctx, u.field))
+}
+
+// validate reports every location of names used by several values.
+func (u *uniqueNames) validate() error {
+	for _, name := range u.names {
+		locations := u.locations[name]
+		if len(locations) < 2 {
+			continue
+		}
+
+		pointers := make([]string, 0, len(locations))
+		for _, ctx := range locations {
+			pointers = append(pointers, "#"+validate.Pointer(ctx))
+		}
+
+		for i, ctx := range locations {
+			others := append(append([]string(nil), pointers[:i]...), pointers[i+1:]...)
+			err := fmt.Errorf(
+				"%s %q is not unique, it is also used at %s: %w",
+				u.field,
+				name,
+				strings.Join(others, ", "),
+				validate.ErrWrongField,
+			)
+			if err := validate.Report(ctx, validate.RuleUnique, err); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateUniqueNames reports operations sharing an operationId and messages sharing a name.
+func (doc *T) validateUniqueNames(ctx context.Context) error {
+	operations, messages := newUniqueNames("operationId"), newUniqueNames("name")
+
+	if components := doc.Components; components != nil {
+		for _, k := range sortedKeys(components.Messages) {
+			if v := components.Messages[k]; v != nil {
+				messages.add(validate.At(ctx, "components", "messages", k), v.Name, v, "")
+			}
+		}
+	}
+
+	for _, k := range sortedKeys(doc.Channels) {
+		channel := doc.Channels[k]
+		if channel == nil {
+			continue
+		}
+
+		for _, v := range []struct {
+			field     string
+			operation *OperationRef
+		}{{"subscribe", channel.Subscribe}, {"publish", channel.Publish}} {
+			if v.operation == nil || v.operation.Value == nil {
+				continue
+			}
+
+			ctx := validate.At(ctx, "channels", k, v.field)
+			operation := v.operation.Value
+
+			operations.add(ctx, operation.OperationID, operation, v.operation.Ref)
+
+			if message := operation.Message; message != nil {
+				ctx := validate.At(ctx, "message")
+
+				if len(message.OneOf) == 0 && message.Value != nil {
+					messages.add(ctx, message.Value.Name, message.Value, message.Ref)
+				}
+
+				for i, ent := range message.OneOf {
+					if ent != nil && ent.Value != nil {
+						messages.add(validate.At(ctx, "oneOf", strconv.Itoa(i)), ent.Value.Name, ent.Value, ent.Ref)
+					}
+				}
+			}
+		}
+	}
+
+	if err := operations.validate(); err != nil {
+		return err
+	}
+
+	return messages.validate()
+}
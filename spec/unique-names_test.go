@@ -0,0 +1,69 @@
+package spec
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/rdmrcv/go-asyncapi2/spec/validate"
+)
+
+func TestT_Validate_UniqueNames(t *testing.T) {
+	doc, err := NewLoader().LoadFromData([]byte(`
+asyncapi: 2.0.0
+info:
+  title: Orders API
+  version: 1.0.0
+channels:
+  orders/created:
+    subscribe:
+      operationId: onOrder
+      message:
+        $ref: '#/components/messages/order'
+  orders/updated:
+    subscribe:
+      operationId: onOrder
+      message:
+        oneOf:
+          - $ref: '#/components/messages/order'
+          - name: order
+  orders/deleted:
+    subscribe:
+      $ref: '#/channels/orders~1created/subscribe'
+components:
+  messages:
+    order:
+      name: order
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = doc.ValidateAll(context.Background())
+
+	var errs validate.Errors
+	if !errors.As(err, &errs) {
+		t.Fatalf("expected validate.Errors, got %v", err)
+	}
+
+	want := []string{
+		"/channels/orders~1created/subscribe/operationId",
+		"/channels/orders~1updated/subscribe/operationId",
+		"/components/messages/order/name",
+		"/channels/orders~1updated/subscribe/message/oneOf/1/name",
+	}
+
+	if len(errs) != len(want) {
+		t.Fatalf("expected %d errors, got %d:\n%v", len(want), len(errs), err)
+	}
+
+	for i, v := range want {
+		if errs[i].Pointer != v || errs[i].Rule != validate.RuleUnique {
+			t.Errorf("expected unique error at %s, got %s error at %s", v, errs[i].Rule, errs[i].Pointer)
+		}
+	}
+
+	if err := doc.Validate(context.Background()); !errors.Is(err, validate.ErrWrongField) {
+		t.Fatalf("expected validate.ErrWrongField, got %v", err)
+	}
+}
@@ -0,0 +1,179 @@
+package spec
+
+import "fmt"
+
+// UpgradeBindings rewrites every binding of the document into the shape of the latest version of its protocol,
+// including bindings of registered protocols that implement bindings.Upgrader.
+//
+// Bindings behind refs are upgraded only when the refs are resolved, see Loader.ResolveRefsIn.
+func (doc *T) UpgradeBindings() error {
+	for _, k := range sortedKeys(doc.Servers) {
+		if v := doc.Servers[k]; v != nil && v.Bindings != nil {
+			if err := v.Bindings.Upgrade(); err != nil {
+				return fmt.Errorf("server %q: %w", k, err)
+			}
+		}
+	}
+
+	for _, k := range sortedKeys(doc.Channels) {
+		if err := upgradeChannel(doc.Channels[k]); err != nil {
+			return fmt.Errorf("channel %q: %w", k, err)
+		}
+	}
+
+	if doc.Components != nil {
+		if err := upgradeComponents(doc.Components); err != nil {
+			return fmt.Errorf("components: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func upgradeComponents(components *Components) error {
+	for _, k := range sortedKeys(components.Messages) {
+		if err := upgradeMessage(components.Messages[k]); err != nil {
+			return fmt.Errorf("message %q: %w", k, err)
+		}
+	}
+
+	for _, k := range sortedKeys(components.OperationTraits) {
+		if v := components.OperationTraits[k]; v != nil && v.Bindings != nil {
+			if err := v.Bindings.Upgrade(); err != nil {
+				return fmt.Errorf("operation trait %q: %w", k, err)
+			}
+		}
+	}
+
+	for _, k := range sortedKeys(components.MessageTraits) {
+		if v := components.MessageTraits[k]; v != nil && v.Bindings != nil {
+			if err := v.Bindings.Upgrade(); err != nil {
+				return fmt.Errorf("message trait %q: %w", k, err)
+			}
+		}
+	}
+
+	for _, k := range sortedKeys(components.ServerBindings) {
+		if v := components.ServerBindings[k]; v != nil {
+			if err := v.Upgrade(); err != nil {
+				return fmt.Errorf("server bindings %q: %w", k, err)
+			}
+		}
+	}
+
+	for _, k := range sortedKeys(components.ChannelBindings) {
+		if v := components.ChannelBindings[k]; v != nil {
+			if err := v.Upgrade(); err != nil {
+				return fmt.Errorf("channel bindings %q: %w", k, err)
+			}
+		}
+	}
+
+	for _, k := range sortedKeys(components.OperationBindings) {
+		if v := components.OperationBindings[k]; v != nil {
+			if err := v.Upgrade(); err != nil {
+				return fmt.Errorf("operation bindings %q: %w", k, err)
+			}
+		}
+	}
+
+	for _, k := range sortedKeys(components.MessageBindings) {
+		if v := components.MessageBindings[k]; v != nil {
+			if err := v.Upgrade(); err != nil {
+				return fmt.Errorf("message bindings %q: %w", k, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func upgradeChannel(channel *Channel) error {
+	if channel == nil {
+		return nil
+	}
+
+	if v := channel.Bindings; v != nil && v.Value != nil {
+		if err := v.Value.Upgrade(); err != nil {
+			return err
+		}
+	}
+
+	if v := channel.Subscribe; v != nil {
+		if err := upgradeOperation(v.Value); err != nil {
+			return fmt.Errorf("subscribe: %w", err)
+		}
+	}
+
+	if v := channel.Publish; v != nil {
+		if err := upgradeOperation(v.Value); err != nil {
+			return fmt.Errorf("publish: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func upgradeOperation(operation *Operation) error {
+	if operation == nil {
+		return nil
+	}
+
+	if v := operation.Bindings; v != nil {
+		if err := v.Upgrade(); err != nil {
+			return err
+		}
+	}
+
+	for i, v := range operation.Traits {
+		if v == nil || v.Value == nil || v.Value.Bindings == nil {
+			continue
+		}
+
+		if err := v.Value.Bindings.Upgrade(); err != nil {
+			return fmt.Errorf("trait %d: %w", i, err)
+		}
+	}
+
+	if v := operation.Message; v != nil {
+		if err := upgradeMessage(v.Value); err != nil {
+			return fmt.Errorf("message: %w", err)
+		}
+
+		for i, v := range v.OneOf {
+			if v == nil {
+				continue
+			}
+
+			if err := upgradeMessage(v.Value); err != nil {
+				return fmt.Errorf("message %d: %w", i, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func upgradeMessage(message *Message) error {
+	if message == nil {
+		return nil
+	}
+
+	if v := message.Bindings; v != nil {
+		if err := v.Upgrade(); err != nil {
+			return err
+		}
+	}
+
+	for i, v := range message.Traits {
+		if v == nil || v.Value == nil || v.Value.Bindings == nil {
+			continue
+		}
+
+		if err := v.Value.Bindings.Upgrade(); err != nil {
+			return fmt.Errorf("trait %d: %w", i, err)
+		}
+	}
+
+	return nil
+}
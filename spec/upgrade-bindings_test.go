@@ -0,0 +1,114 @@
+package spec
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/rdmrcv/go-asyncapi2/spec/bindings"
+)
+
+type inhouseBinding struct {
+	Version  string `json:"version"`
+	upgraded bool
+}
+
+func (*inhouseBinding) Validate(context.Context) error {
+	return nil
+}
+
+func (binding *inhouseBinding) Upgrade() error {
+	if binding.Version == "0.0.1" {
+		return errors.New("version 0.0.1 is not supported")
+	}
+
+	binding.upgraded = true
+
+	return nil
+}
+
+func TestT_UpgradeBindings(t *testing.T) {
+	bindings.RegisterProtocol("inhouse", bindings.Protocol{
+		Server:  func() bindings.Binding { return &inhouseBinding{} },
+		Channel: func() bindings.Binding { return &inhouseBinding{} },
+	})
+	defer bindings.UnregisterProtocol("inhouse")
+
+	doc, err := NewLoader().LoadFromData([]byte(`
+asyncapi: 2.0.0
+info:
+  title: Orders API
+  version: 1.0.0
+servers:
+  production:
+    url: orders.example.com
+    protocol: http
+    bindings:
+      inhouse:
+        version: 1.0.0
+channels:
+  orders:
+    bindings:
+      $ref: '#/components/channelBindings/orders'
+    publish:
+      bindings:
+        http:
+          type: request
+          method: POST
+          bindingVersion: 0.1.0
+      message:
+        traits:
+          - $ref: '#/components/messageTraits/http'
+components:
+  channelBindings:
+    orders:
+      inhouse:
+        version: 1.0.0
+  operationBindings:
+    orders:
+      http:
+        type: response
+        bindingVersion: 0.1.0
+  messageTraits:
+    http:
+      bindings:
+        http:
+          bindingVersion: 0.1.0
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := doc.UpgradeBindings(); err != nil {
+		t.Fatal(err)
+	}
+
+	if v := doc.Servers["production"].Bindings.Custom["inhouse"].(*inhouseBinding); !v.upgraded {
+		t.Error("expected the server binding of the registered protocol to be upgraded")
+	}
+
+	if v := doc.Components.ChannelBindings["orders"].Custom["inhouse"].(*inhouseBinding); !v.upgraded {
+		t.Error("expected the channel binding of the registered protocol to be upgraded")
+	}
+
+	latest := bindings.LatestBindingVersion("http")
+
+	publish := doc.Channels["orders"].Publish.Value
+	if v := publish.Bindings.Http; v.Type != "" || v.BindingVersion != latest {
+		t.Errorf("expected the operation binding to be upgraded, got %+v", v)
+	}
+
+	if v := doc.Components.OperationBindings["orders"].Http; v.Type != "" || v.BindingVersion != latest {
+		t.Errorf("expected the operation binding of components to be upgraded, got %+v", v)
+	}
+
+	if v := doc.Components.MessageTraits["http"].Bindings.Http; v.BindingVersion != latest {
+		t.Errorf("expected the message trait binding to be upgraded, got %+v", v)
+	}
+
+	doc.Servers["production"].Bindings.Custom["inhouse"].(*inhouseBinding).Version = "0.0.1"
+
+	if err := doc.UpgradeBindings(); err == nil {
+		t.Error("expected an error of the server binding")
+	}
+}
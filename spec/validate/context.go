@@ -0,0 +1,128 @@
+package validate
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+type pointerKey struct{}
+
+type collectorKey struct{}
+
+type collector struct {
+	errs    Errors
+	visited map[interface{}]struct{}
+}
+
+// Collect runs the validation with the context which makes Report record errors instead of returning them,
+// so the whole document is validated. It returns Errors holding all found problems, or nil.
+func Collect(ctx context.Context, validate func(ctx context.Context) error) error {
+	c := &collector{visited: make(map[interface{}]struct{})}
+	ctx = context.WithValue(ctx, collectorKey{}, c)
+
+	// Errors not passed to Report are recorded at the location they are returned to.
+	_ = Report(ctx, RuleValue, validate(ctx))
+
+	if len(c.errs) == 0 {
+		return nil
+	}
+
+	return c.errs
+}
+
+// At returns the context for validating the value found by the tokens from the value of the context.
+func At(ctx context.Context, tokens ...string) context.Context {
+	var b strings.Builder
+
+	b.WriteString(Pointer(ctx))
+	for _, token := range tokens {
+		b.WriteByte('/')
+		b.WriteString(strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1"))
+	}
+
+	return context.WithValue(ctx, pointerKey{}, b.String())
+}
+
+// Pointer returns the JSON pointer to the value validated with the context.
+func Pointer(ctx context.Context) string {
+	pointer, _ := ctx.Value(pointerKey{}).(string)
+
+	return pointer
+}
+
+// Report returns the error found in the value validated with the context.
+// When errors are collected, see Collect, the error is recorded and nil is returned, so validation goes on.
+func Report(ctx context.Context, rule string, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	c, ok := ctx.Value(collectorKey{}).(*collector)
+	if !ok {
+		return err
+	}
+
+	c.errs = append(c.errs, &Error{Pointer: Pointer(ctx), Rule: rule, Err: err})
+
+	return nil
+}
+
+// Visit reports whether the value should be validated. When errors are collected,
+// values reachable from several places are validated once.
+func Visit(ctx context.Context, value interface{}) bool {
+	c, ok := ctx.Value(collectorKey{}).(*collector)
+	if !ok {
+		return true
+	}
+
+	if _, ok := c.visited[value]; ok {
+		return false
+	}
+
+	c.visited[value] = struct{}{}
+
+	return true
+}
+
+// Extensions reports fields of the object which do not start with "x-", when extensions validation is enabled.
+// Objects keep fields not defined by the specification among extensions.
+func Extensions(ctx context.Context, extensions map[string]interface{}) error {
+	if !GetOptions(ctx).ExtensionsValidationEnabled() {
+		return nil
+	}
+
+	keys := make([]string, 0, len(extensions))
+	for k := range extensions {
+		if !strings.HasPrefix(k, "x-") {
+			keys = append(keys, k)
+		}
+	}
+
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		err := fmt.Errorf("field %q is not defined and is not an extension: %w", k, ErrWrongField)
+		if err := Report(At(ctx, k), RuleExtension, err); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Schema validates the schema with options of the context and reports its problems.
+func Schema(ctx context.Context, schema *openapi3.Schema) error {
+	if schema == nil || !Visit(ctx, schema) {
+		return nil
+	}
+
+	if err := schema.Validate(ctx, GetOptions(ctx).schemaOptions()...); err != nil {
+		return Report(ctx, RuleSchema, fmt.Errorf("%w: %w", err, ErrWrongField))
+	}
+
+	return nil
+}
@@ -2,6 +2,66 @@ package validate
 
 import (
 	"errors"
+	"fmt"
+	"strings"
 )
 
 var ErrWrongField = errors.New("field is not valid")
+
+// Rules reported with errors.
+const (
+	// RuleRequired is violated when a required field is missing.
+	RuleRequired = "required"
+	// RuleValue is violated when a field has a value not allowed there.
+	RuleValue = "value"
+	// RuleIdentifier is violated when a map key is not a valid identifier.
+	RuleIdentifier = "identifier"
+	// RuleRef is violated when a ref is not resolved.
+	RuleRef = "ref"
+	// RuleSchema is violated when a schema is not valid.
+	RuleSchema = "schema"
+	// RuleExample is violated when an example does not match its schema.
+	RuleExample = "example"
+	// RuleUnique is violated when a name is used by several values, while it should identify one.
+	RuleUnique = "unique"
+	// RuleExtension is violated when a field is neither defined nor an extension.
+	RuleExtension = "extension"
+)
+
+// Error is a problem found at the location in the document.
+type Error struct {
+	// Pointer is a JSON pointer to the invalid value, empty for the whole document.
+	Pointer string
+	// Rule is the violated rule.
+	Rule string
+	Err  error
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("#%s: %s: %v", e.Pointer, e.Rule, e.Err)
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Errors holds all problems found in the document.
+type Errors []*Error
+
+func (e Errors) Error() string {
+	lines := make([]string, 0, len(e))
+	for _, err := range e {
+		lines = append(lines, err.Error())
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func (e Errors) Unwrap() []error {
+	errs := make([]error, 0, len(e))
+	for _, err := range e {
+		errs = append(errs, err)
+	}
+
+	return errs
+}
@@ -0,0 +1,184 @@
+package validate
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// Option allows the modification of how documents are validated.
+type Option func(options *Options)
+
+// Options provides configuration for validating documents.
+type Options struct {
+	examplesValidationDisabled      bool
+	schemaFormatValidationEnabled   bool
+	schemaPatternValidationDisabled bool
+	extensionsValidationEnabled     bool
+	identifierRegExp                *regexp.Regexp
+}
+
+type optionsKey struct{}
+
+// EnableExamplesValidation does the opposite of DisableExamplesValidation.
+// By default, examples are validated.
+func EnableExamplesValidation() Option {
+	return func(options *Options) {
+		options.examplesValidationDisabled = false
+	}
+}
+
+// DisableExamplesValidation disables validation of examples of messages and schemas.
+func DisableExamplesValidation() Option {
+	return func(options *Options) {
+		options.examplesValidationDisabled = true
+	}
+}
+
+// EnableSchemaFormatValidation makes schemas fail validation when they mention formats
+// not defined by the OpenAPIv3 specification. By default, schema format validation is disabled.
+func EnableSchemaFormatValidation() Option {
+	return func(options *Options) {
+		options.schemaFormatValidationEnabled = true
+	}
+}
+
+// DisableSchemaFormatValidation does the opposite of EnableSchemaFormatValidation.
+func DisableSchemaFormatValidation() Option {
+	return func(options *Options) {
+		options.schemaFormatValidationEnabled = false
+	}
+}
+
+// EnableSchemaPatternValidation does the opposite of DisableSchemaPatternValidation.
+// By default, schema pattern validation is enabled.
+func EnableSchemaPatternValidation() Option {
+	return func(options *Options) {
+		options.schemaPatternValidationDisabled = false
+	}
+}
+
+// DisableSchemaPatternValidation makes schemas pass validation when their patterns
+// are not supported by the Go regexp engine.
+func DisableSchemaPatternValidation() Option {
+	return func(options *Options) {
+		options.schemaPatternValidationDisabled = true
+	}
+}
+
+// EnableExtensionsValidation makes objects fail validation when they have fields
+// which are neither defined by the specification nor start with "x-". By default, such fields are ignored.
+func EnableExtensionsValidation() Option {
+	return func(options *Options) {
+		options.extensionsValidationEnabled = true
+	}
+}
+
+// DisableExtensionsValidation does the opposite of EnableExtensionsValidation.
+func DisableExtensionsValidation() Option {
+	return func(options *Options) {
+		options.extensionsValidationEnabled = false
+	}
+}
+
+// WithIdentifierRegExp sets the pattern keys of the components object are validated with,
+// instead of the package wide spec.IdentifierRegExp.
+func WithIdentifierRegExp(re *regexp.Regexp) Option {
+	return func(options *Options) {
+		options.identifierRegExp = re
+	}
+}
+
+// Strict enables all rule groups. Options after it may disable some of them back.
+func Strict() Option {
+	return func(options *Options) {
+		options.examplesValidationDisabled = false
+		options.schemaFormatValidationEnabled = true
+		options.schemaPatternValidationDisabled = false
+		options.extensionsValidationEnabled = true
+	}
+}
+
+// Lenient disables all rule groups, so only fields required by the specification are checked.
+// Options after it may enable some of them back.
+func Lenient() Option {
+	return func(options *Options) {
+		options.examplesValidationDisabled = true
+		options.schemaFormatValidationEnabled = false
+		options.schemaPatternValidationDisabled = true
+		options.extensionsValidationEnabled = false
+	}
+}
+
+// WithOptions returns the context carrying options. They are applied over options the context already has.
+func WithOptions(ctx context.Context, opts ...Option) context.Context {
+	if len(opts) == 0 {
+		return ctx
+	}
+
+	options := *GetOptions(ctx)
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	return context.WithValue(ctx, optionsKey{}, &options)
+}
+
+// GetOptions returns options the context carries.
+func GetOptions(ctx context.Context) *Options {
+	if options, ok := ctx.Value(optionsKey{}).(*Options); ok {
+		return options
+	}
+
+	return &Options{}
+}
+
+// ExamplesValidationEnabled reports whether examples are validated.
+func (options *Options) ExamplesValidationEnabled() bool {
+	return !options.examplesValidationDisabled
+}
+
+// ExtensionsValidationEnabled reports whether fields not starting with "x-" are reported.
+func (options *Options) ExtensionsValidationEnabled() bool {
+	return options.extensionsValidationEnabled
+}
+
+// IdentifierRegExp returns the pattern set by WithIdentifierRegExp, or nil.
+func (options *Options) IdentifierRegExp() *regexp.Regexp {
+	return options.identifierRegExp
+}
+
+// schemaOptions returns options of the schema validation matching the options.
+func (options *Options) schemaOptions() []openapi3.ValidationOption {
+	var opts []openapi3.ValidationOption
+
+	if options.examplesValidationDisabled {
+		opts = append(opts, openapi3.DisableExamplesValidation())
+	}
+
+	if options.schemaFormatValidationEnabled {
+		opts = append(opts, openapi3.EnableSchemaFormatValidation())
+	}
+
+	if options.schemaPatternValidationDisabled {
+		opts = append(opts, openapi3.DisableSchemaPatternValidation())
+	}
+
+	return opts
+}
+
+// SchemaVisitOptions returns options of the validation of values against schemas matching the options.
+func (options *Options) SchemaVisitOptions() []openapi3.SchemaValidationOption {
+	var opts []openapi3.SchemaValidationOption
+
+	if options.schemaFormatValidationEnabled {
+		opts = append(opts, openapi3.EnableFormatValidation())
+	}
+
+	if options.schemaPatternValidationDisabled {
+		opts = append(opts, openapi3.DisablePatternValidation())
+	}
+
+	return opts
+}